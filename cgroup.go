@@ -0,0 +1,123 @@
+package daemontools
+
+import (
+	"errors"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const defaultCgroupParent = "/sys/fs/cgroup/daemontools"
+
+type cgroupConfig struct {
+	path      string
+	memoryMax string
+	cpuWeight string
+	cpuMax    string
+	pidsMax   string
+}
+
+func (self *cgroupConfig) stats() map[string]interface{} {
+	res := map[string]interface{}{"path": self.path}
+	if "" != self.memoryMax {
+		res["memory_max"] = self.memoryMax
+	}
+	if "" != self.cpuWeight {
+		res["cpu_weight"] = self.cpuWeight
+	}
+	if "" != self.cpuMax {
+		res["cpu_max"] = self.cpuMax
+	}
+	if "" != self.pidsMax {
+		res["pids_max"] = self.pidsMax
+	}
+	if s, e := readCgroupFile(self.path, "memory.current"); nil == e {
+		res["memory_current"] = s
+	}
+	if s, e := readCgroupFile(self.path, "pids.current"); nil == e {
+		res["pids_current"] = s
+	}
+	res["oom_kills"] = self.oomKills()
+	return res
+}
+
+func parseCgroupMemory(s string) (string, error) {
+	if "max" == strings.ToLower(s) {
+		return "max", nil
+	}
+	value, e := parseRlimitValue("as", s)
+	if nil != e || rlimitInfinity == value {
+		return "", errors.New("'memory_max' is invalid - " + s)
+	}
+	return strconv.FormatUint(value, 10), nil
+}
+
+// parseCgroupCpuMax 支持 "max"、"50%"、"50000" 和 "50000 100000" 几种格式
+func parseCgroupCpuMax(s string) (string, error) {
+	s = strings.TrimSpace(s)
+	if "max" == strings.ToLower(s) {
+		return "max 100000", nil
+	}
+	if strings.HasSuffix(s, "%") {
+		percent, e := strconv.ParseFloat(strings.TrimSuffix(s, "%"), 64)
+		if nil != e || percent <= 0 {
+			return "", errors.New("'cpu_max' is invalid - " + s)
+		}
+		return strconv.FormatInt(int64(percent*1000), 10) + " 100000", nil
+	}
+
+	ss := strings.Fields(s)
+	switch len(ss) {
+	case 1:
+		ss = append(ss, "100000")
+	case 2:
+	default:
+		return "", errors.New("'cpu_max' is invalid - " + s)
+	}
+	for _, v := range ss {
+		if i, e := strconv.ParseUint(v, 10, 64); nil != e || 0 == i {
+			return "", errors.New("'cpu_max' is invalid - " + s)
+		}
+	}
+	return strings.Join(ss, " "), nil
+}
+
+func loadCgroup(name string, arguments []map[string]interface{}) (*cgroupConfig, error) {
+	cg := &cgroupConfig{}
+	var e error
+	if s := stringWithDefault(arguments[0], "memory_max", ""); "" != s {
+		if cg.memoryMax, e = parseCgroupMemory(s); nil != e {
+			return nil, e
+		}
+	}
+	if s := stringWithDefault(arguments[0], "cpu_weight", ""); "" != s {
+		weight, e := strconv.ParseUint(s, 10, 64)
+		if nil != e || weight < 1 || weight > 10000 {
+			return nil, errors.New("'cpu_weight' must is between 1 and 10000.")
+		}
+		cg.cpuWeight = s
+	}
+	if s := stringWithDefault(arguments[0], "cpu_max", ""); "" != s {
+		if cg.cpuMax, e = parseCgroupCpuMax(s); nil != e {
+			return nil, e
+		}
+	}
+	if s := stringWithDefault(arguments[0], "pids_max", ""); "" != s {
+		if "max" != s {
+			if i, e := strconv.ParseUint(s, 10, 64); nil != e || 0 == i {
+				return nil, errors.New("'pids_max' is invalid - " + s)
+			}
+		}
+		cg.pidsMax = s
+	}
+
+	parent := stringWithArguments(arguments, "cgroup_parent", "")
+	if "" == parent {
+		if "" == cg.memoryMax && "" == cg.cpuWeight && "" == cg.cpuMax && "" == cg.pidsMax {
+			return nil, nil
+		}
+		parent = defaultCgroupParent
+	}
+	cg.path = filepath.Join(parent, name)
+	return cg, nil
+}
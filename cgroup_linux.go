@@ -0,0 +1,143 @@
+package daemontools
+
+import (
+	"bufio"
+	"errors"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const cgroupRoot = "/sys/fs/cgroup"
+
+func readCgroupFile(path, file string) (string, error) {
+	bs, e := ioutil.ReadFile(filepath.Join(path, file))
+	if nil != e {
+		return "", e
+	}
+	return strings.TrimSpace(string(bs)), nil
+}
+
+func writeCgroupFile(path, file, value string) error {
+	return ioutil.WriteFile(filepath.Join(path, file), []byte(value), 0644)
+}
+
+func joinCgroup(path string, pid int) error {
+	return writeCgroupFile(path, "cgroup.procs", strconv.Itoa(pid))
+}
+
+func applyCgroup(cmd *exec.Cmd, path string) {
+	if "" == cmd.Path {
+		return
+	}
+	preexec(cmd, cgroupEnv, path)
+}
+
+// setup 创建 cgroup 并写入资源限制， 上级 cgroup 需要先打开相应的控制器
+func (self *cgroupConfig) setup() error {
+	if _, e := os.Stat(filepath.Join(cgroupRoot, "cgroup.controllers")); nil != e {
+		return errors.New("cgroup v2 is not mounted at '" + cgroupRoot + "'")
+	}
+	if e := os.MkdirAll(self.path, 0755); nil != e {
+		return e
+	}
+
+	var controllers []string
+	if "" != self.memoryMax {
+		controllers = append(controllers, "+memory")
+	}
+	if "" != self.cpuWeight || "" != self.cpuMax {
+		controllers = append(controllers, "+cpu")
+	}
+	if "" != self.pidsMax {
+		controllers = append(controllers, "+pids")
+	}
+	if 0 != len(controllers) {
+		rel, e := filepath.Rel(cgroupRoot, filepath.Dir(self.path))
+		if nil != e || strings.HasPrefix(rel, "..") {
+			return errors.New("cgroup '" + self.path + "' is not under '" + cgroupRoot + "'")
+		}
+		dir := cgroupRoot
+		for _, s := range append([]string{""}, strings.Split(rel, string(filepath.Separator))...) {
+			if "." == s {
+				continue
+			}
+			dir = filepath.Join(dir, s)
+			for _, c := range controllers {
+				// 控制器可能已经打开了， 这里忽略错误， 写限制时会报出来
+				writeCgroupFile(dir, "cgroup.subtree_control", c)
+			}
+		}
+	}
+
+	for _, item := range []struct {
+		file  string
+		value string
+	}{{file: "memory.max", value: self.memoryMax},
+		{file: "cpu.weight", value: self.cpuWeight},
+		{file: "cpu.max", value: self.cpuMax},
+		{file: "pids.max", value: self.pidsMax}} {
+		if "" == item.value {
+			continue
+		}
+		if e := writeCgroupFile(self.path, item.file, item.value); nil != e {
+			return errors.New("write '" + item.value + "' to '" + item.file + "' failed, " + e.Error())
+		}
+	}
+	return nil
+}
+
+// oomKills 返回 memory.events 中的 oom_kill 计数
+func (self *cgroupConfig) oomKills() int {
+	f, e := os.Open(filepath.Join(self.path, "memory.events"))
+	if nil != e {
+		return 0
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if 2 == len(fields) && "oom_kill" == fields[0] {
+			count, _ := strconv.Atoi(fields[1])
+			return count
+		}
+	}
+	return 0
+}
+
+func (self *cgroupConfig) pids() []int {
+	s, e := readCgroupFile(self.path, "cgroup.procs")
+	if nil != e {
+		return nil
+	}
+	var pids []int
+	for _, line := range strings.Fields(s) {
+		if pid, e := strconv.Atoi(line); nil == e {
+			pids = append(pids, pid)
+		}
+	}
+	return pids
+}
+
+// killAll 杀死 cgroup 中所有的进程， 包括那些脱离了进程树的进程
+func (self *cgroupConfig) killAll() error {
+	if e := writeCgroupFile(self.path, "cgroup.kill", "1"); nil != e {
+		for _, pid := range self.pids() {
+			syscall.Kill(pid, syscall.SIGKILL)
+		}
+	}
+
+	for i := 0; i < 50; i++ {
+		if 0 == len(self.pids()) {
+			return nil
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	return errors.New("some processes in the cgroup '" + self.path + "' is still alive")
+}
@@ -0,0 +1,28 @@
+//go:build !linux
+// +build !linux
+
+package daemontools
+
+import (
+	"errors"
+	"os/exec"
+)
+
+func readCgroupFile(path, file string) (string, error) {
+	return "", errors.New("cgroup is unsupported")
+}
+
+func applyCgroup(cmd *exec.Cmd, path string) {
+}
+
+func (self *cgroupConfig) setup() error {
+	return errors.New("cgroup is unsupported")
+}
+
+func (self *cgroupConfig) oomKills() int {
+	return 0
+}
+
+func (self *cgroupConfig) killAll() error {
+	return nil
+}
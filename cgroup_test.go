@@ -0,0 +1,44 @@
+package daemontools
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadCgroup(t *testing.T) {
+	cg, e := loadCgroup("web", []map[string]interface{}{{}})
+	if nil != e || nil != cg {
+		t.Error("excepted cgroup is disabled, actual is", cg, e)
+	}
+
+	cg, e = loadCgroup("web", []map[string]interface{}{{"memory_max": "512m",
+		"cpu_max":    "50%",
+		"cpu_weight": "200",
+		"pids_max":   "100"}})
+	if nil != e {
+		t.Fatal(e)
+	}
+	if filepath.Join(defaultCgroupParent, "web") != cg.path ||
+		"536870912" != cg.memoryMax ||
+		"50000 100000" != cg.cpuMax ||
+		"200" != cg.cpuWeight ||
+		"100" != cg.pidsMax {
+		t.Errorf("cgroup is invalid - %#v", cg)
+	}
+
+	cg, e = loadCgroup("web", []map[string]interface{}{{}, {"cgroup_parent": "/sys/fs/cgroup/tpt"}})
+	if nil != e {
+		t.Fatal(e)
+	} else if "/sys/fs/cgroup/tpt/web" != filepath.ToSlash(cg.path) {
+		t.Error("path is", cg.path)
+	}
+
+	for _, values := range []map[string]interface{}{{"memory_max": "abc"},
+		{"cpu_weight": "0"},
+		{"cpu_max": "1 2 3"},
+		{"pids_max": "-1"}} {
+		if _, e := loadCgroup("web", []map[string]interface{}{values}); nil == e {
+			t.Error(values, "excepted is error, actual is ok")
+		}
+	}
+}
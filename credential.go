@@ -0,0 +1,163 @@
+package daemontools
+
+import (
+	"errors"
+	"os/user"
+	"strconv"
+	"strings"
+)
+
+// credential 是子进程运行时的用户和组
+type credential struct {
+	user   string
+	group  string
+	uid    uint32
+	gid    uint32
+	groups []uint32
+}
+
+func (self *credential) String() string {
+	s := self.user + "(" + strconv.FormatUint(uint64(self.uid), 10) + "):" +
+		self.group + "(" + strconv.FormatUint(uint64(self.gid), 10) + ")"
+	if 0 != len(self.groups) {
+		ss := make([]string, 0, len(self.groups))
+		for _, gid := range self.groups {
+			ss = append(ss, strconv.FormatUint(uint64(gid), 10))
+		}
+		s += " groups(" + strings.Join(ss, ",") + ")"
+	}
+	return s
+}
+
+// encode 和 decode 用于在环境变量中传递 credential， 格式为 "uid:gid:g1,g2"
+func (self *credential) encode() string {
+	ss := make([]string, 0, len(self.groups))
+	for _, gid := range self.groups {
+		ss = append(ss, strconv.FormatUint(uint64(gid), 10))
+	}
+	return strconv.FormatUint(uint64(self.uid), 10) + ":" +
+		strconv.FormatUint(uint64(self.gid), 10) + ":" + strings.Join(ss, ",")
+}
+
+func decodeCredential(s string) (*credential, error) {
+	ss := strings.Split(s, ":")
+	if 3 != len(ss) {
+		return nil, errors.New("credential '" + s + "' is invalid.")
+	}
+	uid, e := strconv.ParseUint(ss[0], 10, 32)
+	if nil != e {
+		return nil, errors.New("credential '" + s + "' is invalid.")
+	}
+	gid, e := strconv.ParseUint(ss[1], 10, 32)
+	if nil != e {
+		return nil, errors.New("credential '" + s + "' is invalid.")
+	}
+	cred := &credential{uid: uint32(uid), gid: uint32(gid)}
+	if "" != ss[2] {
+		for _, g := range strings.Split(ss[2], ",") {
+			id, e := strconv.ParseUint(g, 10, 32)
+			if nil != e {
+				return nil, errors.New("credential '" + s + "' is invalid.")
+			}
+			cred.groups = append(cred.groups, uint32(id))
+		}
+	}
+	return cred, nil
+}
+
+func lookupGroup(name string) (uint32, string, error) {
+	g, e := user.LookupGroup(name)
+	if nil != e {
+		if id, err := strconv.ParseUint(name, 10, 32); nil == err {
+			if g, e := user.LookupGroupId(name); nil == e {
+				return uint32(id), g.Name, nil
+			}
+			return uint32(id), name, nil
+		}
+		return 0, "", errors.New("group '" + name + "' is not found.")
+	}
+	id, e := strconv.ParseUint(g.Gid, 10, 32)
+	if nil != e {
+		return 0, "", errors.New("gid of group '" + name + "' is invalid - " + g.Gid)
+	}
+	return uint32(id), g.Name, nil
+}
+
+// loadCredential 读取 user、group 和 supplementary_groups， 未设置 user 和
+// group 时返回 nil， 即和 daemontools 使用相同的用户。
+func loadCredential(args map[string]interface{}) (*credential, error) {
+	userName := stringWithDefault(args, "user", "")
+	groupName := stringWithDefault(args, "group", "")
+	groupNames := stringsWithDefault(args, "supplementary_groups", ",", nil)
+	if "" == userName && "" == groupName {
+		if 0 != len(groupNames) {
+			return nil, errors.New("'supplementary_groups' is require 'user' or 'group'.")
+		}
+		return nil, nil
+	}
+	if !credentialSupported {
+		return nil, errors.New("'user' and 'group' is unsupported on this platform.")
+	}
+
+	cred := &credential{}
+	if "" != userName {
+		u, e := user.Lookup(userName)
+		if nil != e {
+			if _, err := strconv.ParseUint(userName, 10, 32); nil != err {
+				return nil, errors.New("user '" + userName + "' is not found.")
+			}
+			u, e = user.LookupId(userName)
+			if nil != e {
+				u = &user.User{Uid: userName, Gid: userName, Username: userName}
+			}
+		}
+		uid, e := strconv.ParseUint(u.Uid, 10, 32)
+		if nil != e {
+			return nil, errors.New("uid of user '" + userName + "' is invalid - " + u.Uid)
+		}
+		gid, e := strconv.ParseUint(u.Gid, 10, 32)
+		if nil != e {
+			return nil, errors.New("gid of user '" + userName + "' is invalid - " + u.Gid)
+		}
+		cred.user = u.Username
+		cred.uid = uint32(uid)
+		cred.gid = uint32(gid)
+		cred.group = u.Gid
+		if g, e := user.LookupGroupId(u.Gid); nil == e {
+			cred.group = g.Name
+		}
+	} else {
+		current, e := user.Current()
+		if nil != e {
+			return nil, errors.New("read current user failed, " + e.Error())
+		}
+		uid, e := strconv.ParseUint(current.Uid, 10, 32)
+		if nil != e {
+			return nil, errors.New("uid of current user is invalid - " + current.Uid)
+		}
+		cred.user = current.Username
+		cred.uid = uint32(uid)
+	}
+
+	if "" != groupName {
+		gid, name, e := lookupGroup(groupName)
+		if nil != e {
+			return nil, e
+		}
+		cred.gid = gid
+		cred.group = name
+	}
+
+	for _, name := range groupNames {
+		name = strings.TrimSpace(name)
+		if "" == name {
+			continue
+		}
+		gid, _, e := lookupGroup(name)
+		if nil != e {
+			return nil, e
+		}
+		cred.groups = append(cred.groups, gid)
+	}
+	return cred, nil
+}
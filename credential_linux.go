@@ -0,0 +1,42 @@
+package daemontools
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+const credentialSupported = true
+
+// applyCredential 设置子进程的用户和组， 子进程需要先以本程序启动时(见
+// preexec)， 用户和组在 init() 中设置好资源限制和 cgroup 之后再切换。
+func applyCredential(cmd *exec.Cmd, cred *credential) {
+	if nil == cred || "" == cmd.Path {
+		return
+	}
+	if preexecBinPath == cmd.Path {
+		preexec(cmd, credentialEnv, cred.encode())
+		return
+	}
+	if nil == cmd.SysProcAttr {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.Credential = &syscall.Credential{Uid: cred.uid, Gid: cred.gid, Groups: cred.groups}
+}
+
+func setCredential(spec string) error {
+	cred, e := decodeCredential(spec)
+	if nil != e {
+		return e
+	}
+	groups := make([]int, 0, len(cred.groups))
+	for _, gid := range cred.groups {
+		groups = append(groups, int(gid))
+	}
+	if e := syscall.Setgroups(groups); nil != e {
+		return e
+	}
+	if e := syscall.Setgid(int(cred.gid)); nil != e {
+		return e
+	}
+	return syscall.Setuid(int(cred.uid))
+}
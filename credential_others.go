@@ -0,0 +1,21 @@
+//go:build !linux && !windows
+// +build !linux,!windows
+
+package daemontools
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+const credentialSupported = true
+
+func applyCredential(cmd *exec.Cmd, cred *credential) {
+	if nil == cred || "" == cmd.Path {
+		return
+	}
+	if nil == cmd.SysProcAttr {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.Credential = &syscall.Credential{Uid: cred.uid, Gid: cred.gid, Groups: cred.groups}
+}
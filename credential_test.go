@@ -0,0 +1,80 @@
+package daemontools
+
+import (
+	"os"
+	"os/user"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestLoadCredential(t *testing.T) {
+	if !credentialSupported {
+		t.Skip("credential is unsupported on", runtime.GOOS)
+	}
+
+	cred, e := loadCredential(map[string]interface{}{})
+	if nil != e || nil != cred {
+		t.Error(cred, e)
+	}
+
+	if _, e = loadCredential(map[string]interface{}{"supplementary_groups": []interface{}{"0"}}); nil == e {
+		t.Error("excepted error")
+	}
+
+	if _, e = loadCredential(map[string]interface{}{"user": "daemontools_not_exists"}); nil == e {
+		t.Error("excepted error")
+	}
+
+	u, e := user.Current()
+	if nil != e {
+		t.Skip(e)
+	}
+	cred, e = loadCredential(map[string]interface{}{"user": u.Username,
+		"group":                u.Gid,
+		"supplementary_groups": []interface{}{u.Gid}})
+	if nil != e {
+		t.Fatal(e)
+	}
+	if u.Uid != strconv.Itoa(int(cred.uid)) || u.Gid != strconv.Itoa(int(cred.gid)) || 1 != len(cred.groups) {
+		t.Errorf("%#v", cred)
+	}
+
+	decoded, e := decodeCredential(cred.encode())
+	if nil != e {
+		t.Fatal(e)
+	}
+	if decoded.encode() != cred.encode() {
+		t.Error(decoded.encode(), cred.encode())
+	}
+}
+
+func TestCommandWithCredential(t *testing.T) {
+	if "linux" != runtime.GOOS || 0 != os.Getuid() {
+		t.Skip("require root on linux")
+	}
+	cred, e := loadCredential(map[string]interface{}{"user": "nobody"})
+	if nil != e {
+		t.Skip(e)
+	}
+
+	for _, limits := range [][]rlimit{nil, {{name: "nofile", soft: 512, hard: 512}}} {
+		cmd := (&command{proc: "sh",
+			arguments:  []string{"-c", "id -u; ulimit -n"},
+			credential: cred,
+			limits:     limits}).command("")
+		bs, e := cmd.CombinedOutput()
+		if nil != e {
+			t.Error(string(bs), e)
+			continue
+		}
+		ss := strings.Fields(string(bs))
+		if 2 != len(ss) || strconv.Itoa(int(cred.uid)) != ss[0] {
+			t.Error(string(bs))
+		}
+		if nil != limits && "512" != ss[1] {
+			t.Error(string(bs))
+		}
+	}
+}
@@ -0,0 +1,10 @@
+package daemontools
+
+import (
+	"os/exec"
+)
+
+const credentialSupported = false
+
+func applyCredential(cmd *exec.Cmd, cred *credential) {
+}
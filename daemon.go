@@ -11,7 +11,10 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
 	"runtime"
+	"sort"
+	"strconv"
 	"strings"
 	"text/template"
 	"time"
@@ -191,18 +194,30 @@ func New(arguments map[string]interface{}) (*Manager, error) {
 	}
 
 	if len(mgr.settings) > 0 {
-		for k, s := range mgr.settings {
+		// 先处理实例组再处理组中的实例， 实例的名称总是比组名长
+		var keys []string
+		for k := range mgr.settings {
 			if strings.HasSuffix(k, ".disabled") {
-				name := strings.TrimSpace(strings.TrimSuffix(k, ".disabled"))
-				switch strings.ToLower(fmt.Sprint(s)) {
-				case "1", "yes", "true":
-					mgr.Disable(name)
-				case "0", "no", "false":
-					mgr.Enable(name)
-				default:
-					log.Println("'" + k + "=" + fmt.Sprint(s) + "' is invalid.")
-					os.Exit(1)
-				}
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			if len(keys[i]) != len(keys[j]) {
+				return len(keys[i]) < len(keys[j])
+			}
+			return keys[i] < keys[j]
+		})
+		for _, k := range keys {
+			s := mgr.settings[k]
+			name := strings.TrimSpace(strings.TrimSuffix(k, ".disabled"))
+			switch strings.ToLower(fmt.Sprint(s)) {
+			case "1", "yes", "true":
+				mgr.Disable(name)
+			case "0", "no", "false":
+				mgr.Enable(name)
+			default:
+				log.Println("'" + k + "=" + fmt.Sprint(s) + "' is invalid.")
+				os.Exit(1)
 			}
 		}
 	}
@@ -314,14 +329,15 @@ func execute(pa string) error {
 	return cmd.Run()
 }
 
-func loadConfigs(root string, subdirs []string, execute string, files []string, defaultArgs map[string]interface{}) (*Manager, error) {
+// loadSupervisors 读取配置文件并按 patterns 加载所有的服务， 它不会打开日志文件
+func loadSupervisors(root string, subdirs []string, execute string, files []string, defaultArgs map[string]interface{}, on func(string, int32)) (map[string]interface{}, []supervisor, error) {
 	var arguments map[string]interface{}
 	//"autostart_"
 	if len(files) > 0 {
 		var e error
 		arguments, e = loadProperties(root, files)
 		if nil != e {
-			return nil, e
+			return nil, nil, e
 		}
 	} else {
 		log.Println("[warn] the daemon config file is not found.")
@@ -362,14 +378,11 @@ func loadConfigs(root string, subdirs []string, execute string, files []string,
 			filepath.Clean(abs(filepath.Join(subdir, "*/autostart_*.conf"))))
 	}
 
-	mgr := &Manager{
-		cr: cron.New(),
-	}
 	supervisors := make([]supervisor, 0, 10)
 	for _, pattern := range patterns {
 		matches, e := filepath.Glob(pattern)
 		if nil != e {
-			return nil, errors.New("search '" + pattern + "' failed, " + e.Error())
+			return nil, nil, errors.New("search '" + pattern + "' failed, " + e.Error())
 		}
 
 		if nil == matches {
@@ -377,14 +390,32 @@ func loadConfigs(root string, subdirs []string, execute string, files []string,
 		}
 
 		for _, nm := range matches {
-			supervisors, e = loadConfig(nm, arguments, mgr.onEvent, supervisors)
+			supervisors, e = loadConfig(nm, arguments, on, supervisors)
 			if nil != e {
-				return nil, errors.New("load '" + nm + "' failed, " + e.Error())
+				return nil, nil, errors.New("load '" + nm + "' failed, " + e.Error())
 			}
 			log.Println("load '" + nm + "' is ok.")
 		}
 	}
 
+	if e := checkDependencies(supervisors); nil != e {
+		return nil, nil, e
+	}
+	return arguments, supervisors, nil
+}
+
+func loadConfigs(root string, subdirs []string, execute string, files []string, defaultArgs map[string]interface{}) (*Manager, error) {
+	mgr := &Manager{
+		cr: cron.New(),
+	}
+	mgr.load = func() (map[string]interface{}, []supervisor, error) {
+		return loadSupervisors(root, subdirs, execute, files, defaultArgs, mgr.onEvent)
+	}
+	arguments, supervisors, e := mgr.load()
+	if nil != e {
+		return nil, e
+	}
+
 	if LogDir == "" {
 		logPath := filepath.Clean(abs(filepath.Join(root, "logs")))
 		logs := []string{stringWithDefault(arguments, "logPath", logPath),
@@ -404,22 +435,8 @@ func loadConfigs(root string, subdirs []string, execute string, files []string,
 		os.Mkdir(LogDir, 0660)
 	}
 
-	logArguments := mapWithDefault(arguments, "log", map[string]interface{}{})
-	maxBytes := bytesWithDefault(logArguments, "maxBytes", 0)
-	maxNum := intWithDefault(logArguments, "maxNum", 0)
-	if maxBytes < 1*1024*1024 {
-		maxBytes = 5 * 1024 * 1024
-	}
-	if maxNum <= 0 {
-		maxNum = 5
-	}
-
-	for _, s := range supervisors {
-		out, e := NewRotateFile(filepath.Clean(abs(filepath.Join(LogDir, s.name()+".log"))), maxBytes, maxNum)
-		if nil != e {
-			return nil, errors.New("open log failed for '" + s.name() + "', " + e.Error())
-		}
-		s.setOutput(out)
+	if e := openLogs(arguments, supervisors); nil != e {
+		return nil, e
 	}
 
 	file := filepath.Join(RootDir, "data", "conf", "daemon.properties")
@@ -439,6 +456,33 @@ func loadConfigs(root string, subdirs []string, execute string, files []string,
 	return mgr, nil
 }
 
+// openLogs 为 supervisors 打开日志文件
+func openLogs(arguments map[string]interface{}, supervisors []supervisor) error {
+	logArguments := mapWithDefault(arguments, "log", map[string]interface{}{})
+	maxBytes := bytesWithDefault(logArguments, "maxBytes", 0)
+	maxNum := intWithDefault(logArguments, "maxNum", 0)
+	if maxBytes < 1*1024*1024 {
+		maxBytes = 5 * 1024 * 1024
+	}
+	if maxNum <= 0 {
+		maxNum = 5
+	}
+
+	for _, s := range supervisors {
+		out, e := NewRotateFile(filepath.Clean(abs(filepath.Join(LogDir, s.name()+".log"))), maxBytes, maxNum)
+		if nil != e {
+			return errors.New("open log failed for '" + s.name() + "', " + e.Error())
+		}
+		if uid, gid, ok := s.logOwner(); ok {
+			if e := out.chown(uid, gid); nil != e {
+				return errors.New("change owner of log failed for '" + s.name() + "', " + e.Error())
+			}
+		}
+		s.setOutput(out)
+	}
+	return nil
+}
+
 func loadConfig(file string, args map[string]interface{}, on func(string, int32), supervisors []supervisor) ([]supervisor, error) {
 	t, e := loadTemplateFile(file)
 	if nil != e {
@@ -463,8 +507,11 @@ func loadConfig(file string, args map[string]interface{}, on func(string, int32)
 	}
 	switch value := v.(type) {
 	case map[string]interface{}:
+		if _, ok := value["instances"]; ok {
+			return loadInstances(file, t, args, -1, value, on, supervisors)
+		}
 		arguments := []map[string]interface{}{value, args}
-		return loadSupervisor(file, arguments, on, supervisors)
+		return loadSupervisor(file, arguments, nil, on, supervisors)
 	case []interface{}:
 		for idx, o := range value {
 			attributes, ok := o.(map[string]interface{})
@@ -472,8 +519,12 @@ func loadConfig(file string, args map[string]interface{}, on func(string, int32)
 				return nil, fmt.Errorf("[%v] is not a map or array.", idx)
 			}
 
-			arguments := []map[string]interface{}{attributes, args}
-			supervisors, e = loadSupervisor(file, arguments, on, supervisors)
+			if _, ok := attributes["instances"]; ok {
+				supervisors, e = loadInstances(file, t, args, idx, attributes, on, supervisors)
+			} else {
+				arguments := []map[string]interface{}{attributes, args}
+				supervisors, e = loadSupervisor(file, arguments, nil, on, supervisors)
+			}
 			if nil != e {
 				return nil, e
 			}
@@ -483,7 +534,7 @@ func loadConfig(file string, args map[string]interface{}, on func(string, int32)
 	return nil, fmt.Errorf("it is not a map or array - %T", v)
 }
 
-func loadSupervisor(file string, arguments []map[string]interface{}, on func(string, int32), supervisors []supervisor) ([]supervisor, error) {
+func loadSupervisor(file string, arguments []map[string]interface{}, inst *serviceInstance, on func(string, int32), supervisors []supervisor) ([]supervisor, error) {
 	// type supervisor struct {
 	//   name              string
 	//   success_flag      string
@@ -497,6 +548,13 @@ func loadSupervisor(file string, arguments []map[string]interface{}, on func(str
 	if 0 == len(name) {
 		return nil, errors.New("'name' is missing.")
 	}
+	var group string
+	var instance int
+	if nil != inst {
+		name = inst.name()
+		group = inst.group
+		instance = inst.index
+	}
 	retries := intWithArguments(arguments, "retries", 5)
 	if retries <= 0 {
 		return nil, errors.New("'retries' must is greate 0.")
@@ -507,6 +565,7 @@ func loadSupervisor(file string, arguments []map[string]interface{}, on func(str
 	}
 	var start *command = nil
 	var stop *command = nil
+	var escalation []signalStep
 
 	o, ok := arguments[0]["start"]
 	if !ok {
@@ -522,6 +581,21 @@ func loadSupervisor(file string, arguments []map[string]interface{}, on func(str
 	if nil != e {
 		return nil, e
 	}
+	start.credential, e = loadCredential(arguments[0])
+	if nil != e {
+		return nil, e
+	}
+
+	if o, ok := arguments[0]["limits"]; ok {
+		m, ok := o.(map[string]interface{})
+		if !ok {
+			return nil, errors.New("'limits' is invalid.")
+		}
+		start.limits, e = loadRlimits(m)
+		if nil != e {
+			return nil, e
+		}
+	}
 
 	o, ok = arguments[0]["stop"]
 	if ok {
@@ -534,10 +608,92 @@ func loadSupervisor(file string, arguments []map[string]interface{}, on func(str
 		if nil != e {
 			return nil, e
 		}
+
+		// stop 中没有指定用户和组时和 start 相同
+		stop.credential, e = loadCredential(m)
+		if nil != e {
+			return nil, e
+		}
+		if nil == stop.credential {
+			stop.credential = start.credential
+		}
+
+		if "__signal__" == stop.proc {
+			if _, e = parseSignalSequence(stop.arguments, killTimeout); nil != e {
+				return nil, errors.New("'stop' is invalid, " + e.Error())
+			}
+		}
+		if ss := stringsWithDefault(m, "escalation", ",", nil); 0 != len(ss) {
+			escalation, e = parseSignalSequence(ss, killTimeout)
+			if nil != e {
+				return nil, errors.New("'escalation' is invalid, " + e.Error())
+			}
+		}
 	}
 
-	successFlag := stringWithArguments(arguments, "success_flag", "")
-	if 0 == len(successFlag) {
+	var reload *command
+	if o, ok := arguments[0]["reload"]; ok {
+		m, ok := o.(map[string]interface{})
+		if !ok {
+			return nil, errors.New("'reload' is invalid.")
+		}
+
+		reload, e = loadCommand(append([]map[string]interface{}{m}, arguments[1:]...))
+		if nil != e {
+			return nil, errors.New("'reload' is invalid, " + e.Error())
+		}
+		reload.credential, e = loadCredential(m)
+		if nil != e {
+			return nil, e
+		}
+		if nil == reload.credential {
+			reload.credential = start.credential
+		}
+
+		switch reload.proc {
+		case "__signal__":
+			if 0 != len(reload.arguments) {
+				if _, _, e = parseSignal(reload.arguments[0]); nil != e {
+					return nil, errors.New("'reload' is invalid, " + e.Error())
+				}
+			}
+		case "__kill___", "__console__":
+			return nil, errors.New("'" + reload.proc + "' is not unsupported for reload")
+		}
+	}
+
+	// 实例的序号和端口通过环境变量传给子进程
+	if nil != inst {
+		for _, cmd := range []*command{start, stop, reload} {
+			if nil != cmd {
+				cmd.environments = append(cmd.environments, inst.environments()...)
+			}
+		}
+	}
+
+	// success_flag 为字符串时按原文匹配， 为数组时每一项都是一个正则表达式
+	var successFlag string
+	var successFlags []*regexp.Regexp
+	if _, ok := arguments[0]["success_flag"].([]interface{}); ok {
+		successFlags, e = compileFlags("success_flag", stringsWithDefault(arguments[0], "success_flag", "", nil))
+		if nil != e {
+			return nil, e
+		}
+	} else {
+		successFlag = stringWithArguments(arguments, "success_flag", "")
+	}
+	failureFlags, e := compileFlags("failure_flag", stringsWithDefault(arguments[0], "failure_flag", "", nil))
+	if nil != e {
+		return nil, e
+	}
+
+	if _, hasReady := arguments[0]["ready"]; 0 == len(successFlag) && 0 == len(successFlags) && !hasReady {
+		// failure_flag 只在进程就绪之前匹配， 没有 success_flag 和 ready 时进程
+		// 启动后就是就绪的
+		if 0 != len(failureFlags) {
+			return nil, errors.New("'failure_flag' is unsupported while 'success_flag' and 'ready' is missing in '" + name + "'.")
+		}
+
 		retries1 := intWithDefault(arguments[0], "retries", 0)
 		if retries1 > 0 {
 			log.Println("[warn] retries will ignore while success_flag is missing in '" + name + "' at '" + file + "'.")
@@ -545,16 +701,66 @@ func loadSupervisor(file string, arguments []map[string]interface{}, on func(str
 	}
 
 	for _, sp := range supervisors {
-		if sp.name() == name {
+		if sp.name() == name || sp.instanceOf() == name {
 			return nil, errors.New("'" + name + "' of '" + file + "' is already exists in the " + sp.fileName())
 		}
+		if "" != group && sp.name() == group {
+			return nil, errors.New("'" + group + "' of '" + file + "' is already exists in the " + sp.fileName())
+		}
 	}
 
 	cleansBefore := stringsWithArguments(arguments, "cleans_on_before", ",", nil, true)
 
 	restartSchedule := stringWithArguments(arguments, "restart_schedule", "")
+	// depends_on 和 groups 只从服务自己的配置中读取， 不使用全局参数， 否则
+	// 全局的 depends_on 会让所有的服务(包括被依赖的服务自己)都依赖它
+	var dependsOn []string
+	for _, dep := range stringsWithDefault(arguments[0], "depends_on", ",", nil) {
+		dep = strings.TrimSpace(dep)
+		if 0 == len(dep) {
+			continue
+		}
+		dependsOn = append(dependsOn, dep)
+	}
+	var groups []string
+	for _, nm := range stringsWithDefault(arguments[0], "groups", ",", nil) {
+		nm = strings.TrimSpace(nm)
+		if 0 == len(nm) {
+			continue
+		}
+		groups = append(groups, nm)
+	}
+	serviceType, e := toServiceType(stringWithDefault(arguments[0], "type", ""))
+	if nil != e {
+		return nil, e
+	}
+
+	watch, e := loadFileWatch(file, arguments)
+	if nil != e {
+		return nil, e
+	}
+
+	mode := stringWithArguments(arguments, "mode", "")
+
+	// settings 是从全局参数中继承后的实际值， 它们和命令一起用于计算配置的摘要
+	settings := map[string]interface{}{"retries": retries,
+		"killTimeout":      killTimeout,
+		"success_flag":     successFlag,
+		"cleans_on_before": cleansBefore,
+		"restart_schedule": restartSchedule,
+		"mode":             mode}
+	if nil != watch {
+		settings["watch"] = []interface{}{watch.patterns, watch.interval, watch.debounce, watch.config}
+	}
+
 	pidfile := stringWithArguments(arguments, "pidfile", "")
 	if 0 != len(pidfile) {
+		if SERVICE_SIMPLE != serviceType {
+			return nil, errors.New("type '" + serviceType + "' is not unsupported for pidfile")
+		}
+		if nil != watch {
+			return nil, errors.New("'watch' is not unsupported for pidfile")
+		}
 		if nil != stop {
 			switch stop.proc {
 			case "__kill___", "":
@@ -565,32 +771,191 @@ func loadSupervisor(file string, arguments []map[string]interface{}, on func(str
 		}
 
 		pidfile = filepath.Clean(abs(pidfile))
+		settings["pidfile"] = pidfile
+		configHash := configFingerprint(file, arguments[0], settings, start, stop, reload)
 		supervisors = append(supervisors, &supervisorWithPidfile{pidfile: pidfile,
 			supervisorBase: supervisorBase{
 				file:            file,
 				proc_name:       name,
+				group:           group,
+				instance:        instance,
+				groups:          groups,
+				configHash:      configHash,
 				restartSchedule: restartSchedule,
+				dependsOn:       dependsOn,
 				cleansBefore:    cleansBefore,
-				mode:            stringWithArguments(arguments, "mode", ""),
+				mode:            mode,
 				retries:         retries,
 				killTimeout:     killTimeout,
 				on:              on,
 				start_cmd:       start,
-				stop_cmd:        stop}})
+				stop_cmd:        stop,
+				reload_cmd:      reload,
+				escalation:      escalation}})
 
 	} else {
+		restartDelay := durationWithArguments(arguments, "restart_delay", 2*time.Second)
+		if restartDelay < 0 {
+			return nil, errors.New("'restart_delay' must is greate or equal 0s.")
+		}
+		restartDelayMax := durationWithArguments(arguments, "restart_delay_max", restartDelay)
+		if restartDelayMax < restartDelay {
+			return nil, errors.New("'restart_delay_max' must is greate or equal 'restart_delay'.")
+		}
+		restartBackoffFactor := floatWithArguments(arguments, "restart_backoff_factor", 1)
+		if restartBackoffFactor < 1 {
+			return nil, errors.New("'restart_backoff_factor' must is greate or equal 1.")
+		}
+		restartBackoffReset := durationWithArguments(arguments, "restart_backoff_reset", 10*time.Minute)
+
+		restartPolicy, e := toRestartPolicy(stringWithDefault(arguments[0], "restart", ""))
+		if nil != e {
+			return nil, e
+		}
+
+		// oneshot 服务只运行一次， 从不重启
+		var output *tailBuffer
+		if SERVICE_ONESHOT == serviceType {
+			restartPolicy = RESTART_NEVER
+			output = &tailBuffer{size: intWithArguments(arguments, "result_log_size", 4096)}
+			if output.size <= 0 {
+				return nil, errors.New("'result_log_size' must is greate 0.")
+			}
+		}
+
+		var cronJob *cronJob
+		if SERVICE_CRON == serviceType {
+			cronJob, e = loadCronJob(arguments[0])
+			if nil != e {
+				return nil, e
+			}
+		}
+		var successExitCodes []int
+		for _, s := range stringsWithDefault(arguments[0], "success_exit_codes", ",", nil) {
+			code, e := strconv.Atoi(strings.TrimSpace(s))
+			if nil != e {
+				return nil, errors.New("'success_exit_codes' is invalid, " + e.Error())
+			}
+			successExitCodes = append(successExitCodes, code)
+		}
+
+		var healthcheck *healthCheck
+		if o, ok := arguments[0]["healthcheck"]; ok {
+			m, ok := o.(map[string]interface{})
+			if !ok {
+				return nil, errors.New("'healthcheck' is invalid.")
+			}
+			healthcheck, e = loadHealthCheck(append([]map[string]interface{}{m}, arguments[1:]...))
+			if nil != e {
+				return nil, e
+			}
+		}
+
+		var ready *readyCheck
+		if o, ok := arguments[0]["ready"]; ok {
+			m, ok := o.(map[string]interface{})
+			if !ok {
+				return nil, errors.New("'ready' is invalid.")
+			}
+			ready, e = loadReadyCheck(append([]map[string]interface{}{m}, arguments[1:]...))
+			if nil != e {
+				return nil, e
+			}
+		}
+
+		startTimeout := durationWithArguments(arguments, "start_timeout", 0)
+		if startTimeout < 0 {
+			return nil, errors.New("'start_timeout' must is greate or equal 0s.")
+		}
+
+		watchdogSilence := durationWithArguments(arguments, "watchdog_silence", 0)
+		if watchdogSilence < 0 {
+			return nil, errors.New("'watchdog_silence' must is greate or equal 0s.")
+		}
+
+		metricsInterval := durationWithArguments(arguments, "metrics_interval", 10*time.Second)
+		if metricsInterval < 0 {
+			return nil, errors.New("'metrics_interval' must is greate or equal 0s.")
+		}
+
+		var thresholds *thresholds
+		if o, ok := arguments[0]["thresholds"]; ok {
+			thresholds, e = loadThresholds(o)
+			if nil != e {
+				return nil, e
+			}
+			if nil != thresholds && 0 == metricsInterval {
+				return nil, errors.New("'thresholds' is require 'metrics_interval' greate 0s.")
+			}
+		}
+
+		cgroup, e := loadCgroup(name, arguments)
+		if nil != e {
+			return nil, e
+		}
+
+		runHistorySize := intWithArguments(arguments, "run_history_size", 10)
+		settings["restart_delay"] = restartDelay
+		settings["restart_delay_max"] = restartDelayMax
+		settings["restart_backoff_factor"] = restartBackoffFactor
+		settings["restart_backoff_reset"] = restartBackoffReset
+		settings["start_timeout"] = startTimeout
+		settings["watchdog_silence"] = watchdogSilence
+		settings["metrics_interval"] = metricsInterval
+		settings["run_history_size"] = runHistorySize
+		if nil != output {
+			settings["result_log_size"] = output.size
+		}
+		if nil != cgroup {
+			settings["cgroup"] = cgroup.path
+		}
+		if nil != healthcheck {
+			settings["healthcheck"] = []interface{}{healthcheck.probe.fingerprint(), healthcheck.interval, healthcheck.startPeriod, healthcheck.threshold}
+		}
+		if nil != ready {
+			settings["ready"] = []interface{}{ready.probe.fingerprint(), ready.interval, ready.timeout}
+		}
+		configHash := configFingerprint(file, arguments[0], settings, start, stop, reload)
+
 		supervisors = append(supervisors, &supervisor_default{success_flag: successFlag,
+			serviceType:          serviceType,
+			cron:                 cronJob,
+			output:               output,
+			cgroup:               cgroup,
+			startTimeout:         startTimeout,
+			watchdogSilence:      watchdogSilence,
+			metricsInterval:      metricsInterval,
+			thresholds:           thresholds,
+			watch:                watch,
+			successFlags:         successFlags,
+			failureFlags:         failureFlags,
+			ready:                ready,
+			healthcheck:          healthcheck,
+			restartPolicy:        restartPolicy,
+			successExitCodes:     successExitCodes,
+			history:              runHistory{size: runHistorySize},
+			restartDelay:         restartDelay,
+			restartDelayMax:      restartDelayMax,
+			restartBackoffFactor: restartBackoffFactor,
+			restartBackoffReset:  restartBackoffReset,
 			supervisorBase: supervisorBase{
 				file:            file,
 				proc_name:       name,
+				group:           group,
+				instance:        instance,
+				groups:          groups,
+				configHash:      configHash,
 				restartSchedule: restartSchedule,
+				dependsOn:       dependsOn,
 				cleansBefore:    cleansBefore,
-				mode:            stringWithArguments(arguments, "mode", ""),
+				mode:            mode,
 				retries:         retries,
 				killTimeout:     killTimeout,
 				on:              on,
 				start_cmd:       start,
-				stop_cmd:        stop}})
+				stop_cmd:        stop,
+				reload_cmd:      reload,
+				escalation:      escalation}})
 	}
 	return supervisors, nil
 }
@@ -0,0 +1,152 @@
+package daemontools
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// groupByInstance 返回实例组名到组中所有实例名称的映射
+func groupByInstance(supervisors []supervisor) map[string][]string {
+	groups := map[string][]string{}
+	for _, sp := range supervisors {
+		if group := sp.instanceOf(); "" != group {
+			groups[group] = append(groups[group], sp.name())
+		}
+	}
+	return groups
+}
+
+// expandDependencies 返回 sp 依赖的服务， 依赖为实例组名时展开为组中所有的实例
+func expandDependencies(groups map[string][]string, sp supervisor) []string {
+	var deps []string
+	for _, dep := range sp.dependencies() {
+		if members, ok := groups[dep]; ok {
+			deps = append(deps, members...)
+		} else {
+			deps = append(deps, dep)
+		}
+	}
+	return deps
+}
+
+func checkDependencies(supervisors []supervisor) error {
+	byName := map[string]supervisor{}
+	for _, sp := range supervisors {
+		byName[sp.name()] = sp
+	}
+	groups := groupByInstance(supervisors)
+
+	for _, sp := range supervisors {
+		for _, dep := range sp.dependencies() {
+			if dep == sp.name() || ("" != sp.instanceOf() && dep == sp.instanceOf()) {
+				return errors.New("'" + sp.name() + "' of '" + sp.fileName() + "' is depends on itself.")
+			}
+			if _, ok := groups[dep]; ok {
+				continue
+			}
+			if _, ok := byName[dep]; !ok {
+				return errors.New("'" + sp.name() + "' of '" + sp.fileName() + "' is depends on '" + dep + "', but it is not found.")
+			}
+		}
+	}
+
+	const (
+		unvisited = 0
+		visiting  = 1
+		visited   = 2
+	)
+	states := map[string]int{}
+	var path []string
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch states[name] {
+		case visited:
+			return nil
+		case visiting:
+			for idx, nm := range path {
+				if nm == name {
+					return errors.New("circular dependency is found - " +
+						strings.Join(append(path[idx:], name), " -> "))
+				}
+			}
+			return errors.New("circular dependency is found - " + name)
+		}
+
+		states[name] = visiting
+		path = append(path, name)
+		for _, dep := range expandDependencies(groups, byName[name]) {
+			if e := visit(dep); nil != e {
+				return e
+			}
+		}
+		path = path[:len(path)-1]
+		states[name] = visited
+		return nil
+	}
+
+	for _, sp := range supervisors {
+		if e := visit(sp.name()); nil != e {
+			return e
+		}
+	}
+	return nil
+}
+
+// runByDependencies 对 supervisors 并发执行 fn， 但一个服务只有在它依赖的服务
+// 处理完成后才会被处理， reverse 为 true 时顺序相反， 即依赖它的服务先处理。
+// 不在 supervisors 中的依赖会被忽略。
+func runByDependencies(supervisors []supervisor, reverse bool, fn func(sp supervisor) error) []string {
+	type node struct {
+		sp   supervisor
+		done chan struct{}
+		err  error
+	}
+
+	nodes := make(map[string]*node, len(supervisors))
+	for _, sp := range supervisors {
+		nodes[sp.name()] = &node{sp: sp, done: make(chan struct{})}
+	}
+
+	groups := groupByInstance(supervisors)
+	waits := make(map[string][]*node, len(supervisors))
+	for _, sp := range supervisors {
+		for _, dep := range expandDependencies(groups, sp) {
+			target, ok := nodes[dep]
+			if !ok {
+				continue
+			}
+			if reverse {
+				waits[dep] = append(waits[dep], nodes[sp.name()])
+			} else {
+				waits[sp.name()] = append(waits[sp.name()], target)
+			}
+		}
+	}
+
+	for _, sp := range supervisors {
+		n := nodes[sp.name()]
+		go func(n *node, waitList []*node) {
+			defer close(n.done)
+
+			for _, w := range waitList {
+				<-w.done
+				if nil != w.err && !reverse {
+					n.err = fmt.Errorf("'%v' is skipped, because dependency '%v' is failed", n.sp.name(), w.sp.name())
+					return
+				}
+			}
+			n.err = fn(n.sp)
+		}(n, waits[sp.name()])
+	}
+
+	var failed []string
+	for _, sp := range supervisors {
+		n := nodes[sp.name()]
+		<-n.done
+		if nil != n.err {
+			failed = append(failed, n.err.Error())
+		}
+	}
+	return failed
+}
@@ -0,0 +1,108 @@
+package daemontools
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func newDependsSupervisor(name string, dependsOn ...string) supervisor {
+	return &supervisor_default{supervisorBase: supervisorBase{proc_name: name, dependsOn: dependsOn}}
+}
+
+func TestCheckDependencies(t *testing.T) {
+	for _, test := range []struct {
+		supervisors []supervisor
+		err         string
+	}{{supervisors: []supervisor{newDependsSupervisor("db"),
+		newDependsSupervisor("app", "db"),
+		newDependsSupervisor("web", "app", "db")}},
+		{supervisors: []supervisor{newDependsSupervisor("app", "db")},
+			err: "'db', but it is not found"},
+		{supervisors: []supervisor{newDependsSupervisor("app", "app")},
+			err: "depends on itself"},
+		{supervisors: []supervisor{newDependsSupervisor("a", "c"),
+			newDependsSupervisor("b", "a"),
+			newDependsSupervisor("c", "b")},
+			err: "a -> c -> b -> a"}} {
+		e := checkDependencies(test.supervisors)
+		if "" == test.err {
+			if nil != e {
+				t.Error(e)
+			}
+			continue
+		}
+		if nil == e {
+			t.Error("excepted error is", test.err, ", actual is nil")
+		} else if !strings.Contains(e.Error(), test.err) {
+			t.Error("excepted error is", test.err, ", actual is", e)
+		}
+	}
+}
+
+func TestRunByDependencies(t *testing.T) {
+	supervisors := []supervisor{newDependsSupervisor("web", "app"),
+		newDependsSupervisor("app", "db"),
+		newDependsSupervisor("db")}
+
+	var lock sync.Mutex
+	var order []string
+	fn := func(sp supervisor) error {
+		lock.Lock()
+		order = append(order, sp.name())
+		lock.Unlock()
+		return nil
+	}
+
+	if failed := runByDependencies(supervisors, false, fn); 0 != len(failed) {
+		t.Error(failed)
+	}
+	if s := strings.Join(order, ","); "db,app,web" != s {
+		t.Error("start order is", s)
+	}
+
+	order = nil
+	if failed := runByDependencies(supervisors, true, fn); 0 != len(failed) {
+		t.Error(failed)
+	}
+	if s := strings.Join(order, ","); "web,app,db" != s {
+		t.Error("stop order is", s)
+	}
+}
+
+func TestDependsOnIsPerService(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "autostart_app.conf")
+	if e := ioutil.WriteFile(file, []byte(`[{
+  "name": "db",
+  "start": {
+    "execute": "db"
+  }
+}, {
+  "name": "app",
+  "depends_on": "db",
+  "start": {
+    "execute": "app"
+  }
+}]`), 0666); nil != e {
+		t.Fatal(e)
+	}
+
+	supervisors, e := loadConfig(file, map[string]interface{}{"depends_on": "db"}, nil, nil)
+	if nil != e {
+		t.Fatal(e)
+	}
+	if 2 != len(supervisors) {
+		t.Fatal("excepted 2 supervisors, actual is", len(supervisors))
+	}
+	if deps := supervisors[0].dependencies(); 0 != len(deps) {
+		t.Error("db depends on", deps)
+	}
+	if deps := supervisors[1].dependencies(); 1 != len(deps) || "db" != deps[0] {
+		t.Error("app depends on", deps)
+	}
+	if e := checkDependencies(supervisors); nil != e {
+		t.Error(e)
+	}
+}
@@ -0,0 +1,17 @@
+//go:build !windows
+// +build !windows
+
+package daemontools
+
+import (
+	"os"
+	"syscall"
+)
+
+func signalOf(state *os.ProcessState) (string, bool) {
+	status, ok := state.Sys().(syscall.WaitStatus)
+	if !ok || !status.Signaled() {
+		return "", false
+	}
+	return status.Signal().String(), status.CoreDump()
+}
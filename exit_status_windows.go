@@ -0,0 +1,9 @@
+package daemontools
+
+import (
+	"os"
+)
+
+func signalOf(state *os.ProcessState) (string, bool) {
+	return "", false
+}
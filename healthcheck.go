@@ -0,0 +1,132 @@
+package daemontools
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	HEALTH_STARTING  = "starting"
+	HEALTH_HEALTHY   = "healthy"
+	HEALTH_UNHEALTHY = "unhealthy"
+)
+
+type healthCheck struct {
+	probe       *probe
+	interval    time.Duration
+	startPeriod time.Duration
+	threshold   int
+}
+
+type healthState struct {
+	sync.Mutex
+	status    string
+	failures  int
+	lastError string
+	lastCheck time.Time
+}
+
+func (self *healthState) reset() {
+	self.Lock()
+	defer self.Unlock()
+	self.status = HEALTH_STARTING
+	self.failures = 0
+	self.lastError = ""
+	self.lastCheck = time.Time{}
+}
+
+// update 记录一次检查结果， 返回连续失败的次数
+func (self *healthState) update(e error, threshold int) int {
+	self.Lock()
+	defer self.Unlock()
+	self.lastCheck = time.Now()
+	if nil == e {
+		self.status = HEALTH_HEALTHY
+		self.failures = 0
+		return 0
+	}
+
+	self.failures++
+	self.lastError = e.Error()
+	if self.failures >= threshold {
+		self.status = HEALTH_UNHEALTHY
+	}
+	return self.failures
+}
+
+func (self *healthState) stats() map[string]interface{} {
+	self.Lock()
+	defer self.Unlock()
+	res := map[string]interface{}{
+		"status":   self.status,
+		"failures": self.failures,
+	}
+	if "" != self.lastError {
+		res["last_error"] = self.lastError
+	}
+	if !self.lastCheck.IsZero() {
+		res["last_check"] = self.lastCheck
+	}
+	return res
+}
+
+// healthLoop 周期性的执行健康检查， 连续失败次数达到阈值后重启进程，
+// 进程退出时 done 会被关闭。
+func (self *supervisor_default) healthLoop(done <-chan struct{}) {
+	hc := self.healthcheck
+	self.health.reset()
+
+	if hc.startPeriod > 0 {
+		timer := time.NewTimer(hc.startPeriod)
+		select {
+		case <-done:
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+
+	ticker := time.NewTicker(hc.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+
+		e := hc.probe.check(self.mode)
+		failures := self.health.update(e, hc.threshold)
+		if nil == e {
+			continue
+		}
+
+		self.logString(fmt.Sprintf("[sys] healthcheck(%v) failed(%d/%d) - %v\r\n", hc.probe, failures, hc.threshold, e))
+		if failures >= hc.threshold {
+			self.logString("[sys] healthcheck is failed, restart it.\r\n")
+			self.interruptBy(EXIT_REASON_UNHEALTHY)
+			return
+		}
+	}
+}
+
+func loadHealthCheck(args []map[string]interface{}) (*healthCheck, error) {
+	p, e := loadProbe("healthcheck", args, "timeout", 5*time.Second)
+	if nil != e {
+		return nil, e
+	}
+
+	hc := &healthCheck{probe: p,
+		interval:    durationWithDefault(args[0], "interval", 30*time.Second),
+		startPeriod: durationWithDefault(args[0], "start_period", 0),
+		threshold:   intWithDefault(args[0], "failures", 3)}
+	if hc.interval <= 0 {
+		return nil, errors.New("'healthcheck.interval' must is greate 0s.")
+	}
+	if hc.threshold <= 0 {
+		return nil, errors.New("'healthcheck.failures' must is greate 0.")
+	}
+	return hc, nil
+}
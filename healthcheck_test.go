@@ -0,0 +1,111 @@
+package daemontools
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestProbe(t *testing.T) {
+	ln, e := net.Listen("tcp", "127.0.0.1:0")
+	if nil != e {
+		t.Fatal(e)
+	}
+	closedAddress := ln.Addr().String()
+	ln.Close()
+
+	ln, e = net.Listen("tcp", "127.0.0.1:0")
+	if nil != e {
+		t.Fatal(e)
+	}
+	defer ln.Close()
+	go func() {
+		for {
+			conn, e := ln.Accept()
+			if nil != e {
+				return
+			}
+			conn.Close()
+		}
+	}()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if "/fail" == r.URL.Path {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	for _, test := range []struct {
+		probe *probe
+		ok    bool
+	}{{probe: &probe{typ: PROBE_TCP, address: ln.Addr().String(), timeout: time.Second}, ok: true},
+		{probe: &probe{typ: PROBE_TCP, address: closedAddress, timeout: time.Second}, ok: false},
+		{probe: &probe{typ: PROBE_HTTP, url: srv.URL + "/ok", timeout: time.Second}, ok: true},
+		{probe: &probe{typ: PROBE_HTTP, url: srv.URL + "/fail", timeout: time.Second}, ok: false}} {
+		e := test.probe.check("")
+		if test.ok && nil != e {
+			t.Error(test.probe, e)
+		} else if !test.ok && nil == e {
+			t.Error(test.probe, "excepted is failed, actual is ok")
+		}
+	}
+}
+
+func TestHealthCheckRestart(t *testing.T) {
+	ln, e := net.Listen("tcp", "127.0.0.1:0")
+	if nil != e {
+		t.Fatal(e)
+	}
+	closedAddress := ln.Addr().String()
+	ln.Close()
+
+	// 健康检查失败时总是重启， 不受重启策略的影响
+	for _, policy := range []string{"", RESTART_NEVER, RESTART_ON_FAILURE} {
+		var buffer lockedBuffer
+		wd, _ := os.Getwd()
+		s := &supervisor_default{success_flag: "ok",
+			restartPolicy: policy,
+			healthcheck: &healthCheck{probe: &probe{typ: PROBE_TCP, address: closedAddress, timeout: time.Second},
+				interval:  50 * time.Millisecond,
+				threshold: 2},
+			supervisorBase: supervisorBase{proc_name: "test_healthcheck",
+				retries:     5,
+				killTimeout: time.Second,
+				out:         &buffer,
+				start_cmd: &command{proc: "go",
+					arguments: []string{"run", filepath.Join(wd, "mock", "run_forever.go")}}}}
+
+		s.start()
+		if e := s.untilStarted(); nil != e {
+			t.Fatal(e)
+		}
+
+		record, pid, ok := untilRestarted(s, 10*time.Second)
+		if !ok {
+			s.stop()
+			s.untilStopped()
+			t.Fatal("process is not restarted with", policy, "-", buffer.String())
+		}
+		if EXIT_REASON_UNHEALTHY != record.Reason {
+			t.Errorf("run is invalid - %#v", record)
+		}
+		if SRV_RUNNING != atomic.LoadInt32(&s.srv_status) || 0 == pid {
+			t.Error("status is", srvString(atomic.LoadInt32(&s.srv_status)))
+		}
+		if ss := buffer.String(); !strings.Contains(ss, "healthcheck is failed") {
+			t.Error(ss)
+		}
+
+		s.stop()
+		s.untilStopped()
+	}
+}
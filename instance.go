@@ -0,0 +1,101 @@
+package daemontools
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"text/template"
+)
+
+// serviceInstance 是 instances 展开后的一个实例
+type serviceInstance struct {
+	group string
+	index int
+	port  int
+}
+
+func (self *serviceInstance) name() string {
+	return self.group + "-" + strconv.Itoa(self.index)
+}
+
+func (self *serviceInstance) environments() []string {
+	environments := []string{"DAEMON_INSTANCE=" + strconv.Itoa(self.index)}
+	if self.port > 0 {
+		environments = append(environments, "DAEMON_PORT="+strconv.Itoa(self.port))
+	}
+	return environments
+}
+
+// loadInstances 将 instances 为 N 的服务展开为 name-0 到 name-(N-1) 共 N 个服务，
+// 每个实例都会用 instance 和 port 重新生成一次配置文件， idx 是服务在配置文件中
+// 的位置， 配置文件不是数组时为 -1。
+func loadInstances(file string, t *template.Template, args map[string]interface{}, idx int, attributes map[string]interface{}, on func(string, int32), supervisors []supervisor) ([]supervisor, error) {
+	group := stringWithDefault(attributes, "name", "")
+	if 0 == len(group) {
+		return nil, errors.New("'name' is missing.")
+	}
+	instances := intWithDefault(attributes, "instances", 0)
+	if instances <= 0 {
+		return nil, errors.New("'instances' of '" + group + "' must is greate 0.")
+	}
+	portBase := intWithDefault(attributes, "port_base", 0)
+	if portBase < 0 {
+		return nil, errors.New("'port_base' of '" + group + "' must is greate or equal 0.")
+	}
+	portStep := intWithDefault(attributes, "port_step", 1)
+	if portStep <= 0 {
+		return nil, errors.New("'port_step' of '" + group + "' must is greate 0.")
+	}
+
+	for i := 0; i < instances; i++ {
+		inst := &serviceInstance{group: group, index: i}
+		if portBase > 0 {
+			inst.port = portBase + i*portStep
+		}
+
+		data := make(map[string]interface{}, len(args)+3)
+		for k, v := range args {
+			data[k] = v
+		}
+		data["instance"] = i
+		data["instances"] = instances
+		// 没有 port_base 时不覆盖全局参数中的 port
+		if inst.port > 0 {
+			data["port"] = inst.port
+		}
+
+		var buffer bytes.Buffer
+		if e := t.Execute(&buffer, data); nil != e {
+			return nil, errors.New("regenerate file for '" + inst.name() + "' failed, " + e.Error())
+		}
+		var v interface{}
+		if e := Unmarshal(buffer.Bytes(), &v); nil != e {
+			log.Println(buffer.String())
+			return nil, errors.New("ummarshal file for '" + inst.name() + "' failed, " + e.Error())
+		}
+
+		var attrs map[string]interface{}
+		switch value := v.(type) {
+		case map[string]interface{}:
+			if idx < 0 {
+				attrs = value
+			}
+		case []interface{}:
+			if idx >= 0 && idx < len(value) {
+				attrs, _ = value[idx].(map[string]interface{})
+			}
+		}
+		if nil == attrs {
+			return nil, fmt.Errorf("'%v' is invalid, the file is changed with the instance.", inst.name())
+		}
+
+		var e error
+		supervisors, e = loadSupervisor(file, []map[string]interface{}{attrs, args}, inst, on, supervisors)
+		if nil != e {
+			return nil, e
+		}
+	}
+	return supervisors, nil
+}
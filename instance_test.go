@@ -0,0 +1,142 @@
+package daemontools
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestLoadInstances(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "autostart_worker.conf")
+	if e := ioutil.WriteFile(file, []byte(`[{
+  "name": "worker",
+  "instances": 3,
+  "port_base": 8000,
+  "port_step": 10,
+  "start": {
+    "execute": "worker",
+    "arguments": ["--id={{.instance}}", "--port={{.port}}"]
+  }
+}, {
+  "name": "web",
+  "depends_on": "worker",
+  "start": {
+    "execute": "web"
+  }
+}]`), 0666); nil != e {
+		t.Fatal(e)
+	}
+
+	supervisors, e := loadConfig(file, map[string]interface{}{}, nil, nil)
+	if nil != e {
+		t.Fatal(e)
+	}
+	if 4 != len(supervisors) {
+		t.Fatal("excepted 4 supervisors, actual is", len(supervisors))
+	}
+	if e := checkDependencies(supervisors); nil != e {
+		t.Error(e)
+	}
+
+	for idx, excepted := range []struct {
+		name      string
+		arguments string
+		env       string
+	}{{name: "worker-0", arguments: "--id=0 --port=8000", env: "DAEMON_INSTANCE=0 DAEMON_PORT=8000"},
+		{name: "worker-1", arguments: "--id=1 --port=8010", env: "DAEMON_INSTANCE=1 DAEMON_PORT=8010"},
+		{name: "worker-2", arguments: "--id=2 --port=8020", env: "DAEMON_INSTANCE=2 DAEMON_PORT=8020"}} {
+		s := supervisors[idx].(*supervisor_default)
+		if excepted.name != s.name() {
+			t.Error("excepted name is", excepted.name, ", actual is", s.name())
+		}
+		if "worker" != s.instanceOf() || idx != s.instance {
+			t.Error("excepted instance is worker", idx, ", actual is", s.instanceOf(), s.instance)
+		}
+		if actual := strings.Join(s.start_cmd.arguments, " "); excepted.arguments != actual {
+			t.Error("excepted arguments is", excepted.arguments, ", actual is", actual)
+		}
+		if actual := strings.Join(s.start_cmd.environments, " "); excepted.env != actual {
+			t.Error("excepted environments is", excepted.env, ", actual is", actual)
+		}
+	}
+
+	mgr := &Manager{supervisors: supervisors}
+	if 3 != len(mgr.lookup("worker")) {
+		t.Error("excepted 3 instances of worker, actual is", len(mgr.lookup("worker")))
+	}
+	if 1 != len(mgr.lookup("worker-1")) {
+		t.Error("worker-1 is not found")
+	}
+
+	mgr.Disable("worker")
+	for _, nm := range []string{"worker-0", "worker-1", "worker-2"} {
+		if !mgr.IsSipped(nm) {
+			t.Error(nm, "is not skipped")
+		}
+	}
+	mgr.Enable("worker-1")
+	if mgr.IsSipped("worker-1") || !mgr.IsSipped("worker-0") {
+		t.Error("enable worker-1 failed -", mgr.skipped)
+	}
+	mgr.Enable("worker")
+	if 0 != len(mgr.skipped) {
+		t.Error("enable worker failed -", mgr.skipped)
+	}
+}
+
+func TestLoadInstancesWithGlobalPort(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "autostart_worker.conf")
+	if e := ioutil.WriteFile(file, []byte(`{
+  "name": "worker",
+  "instances": 2,
+  "start": {
+    "execute": "worker",
+    "arguments": ["--id={{.instance}}", "--port={{.port}}"]
+  }
+}`), 0666); nil != e {
+		t.Fatal(e)
+	}
+
+	supervisors, e := loadConfig(file, map[string]interface{}{"port": 9000}, nil, nil)
+	if nil != e {
+		t.Fatal(e)
+	}
+	if 2 != len(supervisors) {
+		t.Fatal("excepted 2 supervisors, actual is", len(supervisors))
+	}
+	for idx, excepted := range []string{"--id=0 --port=9000", "--id=1 --port=9000"} {
+		s := supervisors[idx].(*supervisor_default)
+		if actual := strings.Join(s.start_cmd.arguments, " "); excepted != actual {
+			t.Error("excepted arguments is", excepted, ", actual is", actual)
+		}
+		if actual := strings.Join(s.start_cmd.environments, " "); "DAEMON_INSTANCE="+strconv.Itoa(idx) != actual {
+			t.Error("environments is", actual)
+		}
+	}
+}
+
+func TestRunByDependenciesWithInstances(t *testing.T) {
+	worker0 := &supervisor_default{supervisorBase: supervisorBase{proc_name: "worker-0", group: "worker"}}
+	worker1 := &supervisor_default{supervisorBase: supervisorBase{proc_name: "worker-1", group: "worker", instance: 1}}
+	supervisors := []supervisor{newDependsSupervisor("web", "worker"), worker0, worker1}
+	if e := checkDependencies(supervisors); nil != e {
+		t.Fatal(e)
+	}
+
+	var order []string
+	failed := runByDependencies(supervisors, false, func(sp supervisor) error {
+		if "web" == sp.name() {
+			order = append(order, sp.name())
+			return nil
+		}
+		if 0 != len(order) {
+			t.Error("web is started before", sp.name())
+		}
+		return nil
+	})
+	if 0 != len(failed) || 1 != len(order) {
+		t.Error(failed, order)
+	}
+}
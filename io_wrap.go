@@ -2,7 +2,11 @@ package daemontools
 
 import (
 	"bytes"
+	"errors"
+	"regexp"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	//"fmt"
 	"io"
@@ -95,6 +99,60 @@ func wrap(out io.Writer, pattern []byte, cb func()) io.Writer {
 	return &matchWriter{pattern: pattern, out: out, cb: cb}
 }
 
+// activityWriter 记录最后一次输出的时间， tail 不为 nil 时还会保留最后的输出
+type activityWriter struct {
+	out    io.Writer
+	lastAt *int64
+	tail   *tailBuffer
+}
+
+func (w *activityWriter) RotateToError() {
+	r, ok := w.out.(RotateError)
+	if ok {
+		r.RotateToError()
+	}
+}
+
+func (self *activityWriter) Write(p []byte) (int, error) {
+	atomic.StoreInt64(self.lastAt, time.Now().UnixNano())
+	if nil != self.tail {
+		self.tail.Write(p)
+	}
+	if nil == self.out {
+		return len(p), nil
+	}
+	return self.out.Write(p)
+}
+
+// tailBuffer 只保留最后写入的 size 个字节
+type tailBuffer struct {
+	sync.Mutex
+	size int
+	buf  []byte
+}
+
+func (self *tailBuffer) Write(p []byte) (int, error) {
+	self.Lock()
+	defer self.Unlock()
+	self.buf = append(self.buf, p...)
+	if len(self.buf) > self.size {
+		self.buf = append(self.buf[:0], self.buf[len(self.buf)-self.size:]...)
+	}
+	return len(p), nil
+}
+
+func (self *tailBuffer) Reset() {
+	self.Lock()
+	defer self.Unlock()
+	self.buf = self.buf[:0]
+}
+
+func (self *tailBuffer) String() string {
+	self.Lock()
+	defer self.Unlock()
+	return string(self.buf)
+}
+
 type safe_writer struct {
 	sync.Mutex
 	out io.Writer
@@ -103,7 +161,17 @@ type safe_writer struct {
 func (self *safe_writer) Write(p []byte) (n int, e error) {
 	self.Lock()
 	defer self.Unlock()
-	return self.Write(p)
+	return self.out.Write(p)
+}
+
+func (self *safe_writer) Close() error {
+	self.Lock()
+	defer self.Unlock()
+
+	if closer, ok := self.out.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
 }
 
 func (self *safe_writer) RotateToError() {
@@ -119,3 +187,114 @@ func (self *safe_writer) RotateToError() {
 func safe_io(out io.Writer) io.Writer {
 	return &safe_writer{out: out}
 }
+
+const maxFlagBuffer = 4096
+
+// flagWriter 用正则表达式匹配输出， 未匹配的最后一行会被缓存下来与后续的
+// 输出一起匹配， 这样一行被分成多次写入时也能匹配上。
+type flagWriter struct {
+	out       io.Writer
+	success   []*regexp.Regexp
+	failure   []*regexp.Regexp
+	onSuccess func()
+	onFailure func(pattern string)
+
+	lock     sync.Mutex
+	buf      []byte
+	isReady  bool
+	isFailed bool
+}
+
+func (w *flagWriter) RotateToError() {
+	r, ok := w.out.(RotateError)
+	if ok {
+		r.RotateToError()
+	}
+}
+
+// setReady 在进程已就绪时调用， 之后不再匹配 failure
+func (self *flagWriter) setReady() {
+	self.lock.Lock()
+	self.isReady = true
+	self.buf = nil
+	self.lock.Unlock()
+}
+
+func (self *flagWriter) Write(p []byte) (n int, err error) {
+	if nil != self.out {
+		n, err = self.out.Write(p)
+	} else {
+		n = len(p)
+	}
+
+	self.lock.Lock()
+	if self.isReady || self.isFailed {
+		self.lock.Unlock()
+		return n, err
+	}
+	self.buf = append(self.buf, p[:n]...)
+
+	var cb func()
+	for _, re := range self.failure {
+		if re.Match(self.buf) {
+			self.isFailed = true
+			pattern := re.String()
+			cb = func() {
+				if nil != self.onFailure {
+					self.onFailure(pattern)
+				}
+			}
+			break
+		}
+	}
+
+	if nil == cb {
+		for _, re := range self.success {
+			if re.Match(self.buf) {
+				self.isReady = true
+				cb = self.onSuccess
+				break
+			}
+		}
+	}
+
+	if self.isReady || self.isFailed {
+		self.buf = nil
+	} else {
+		if idx := bytes.LastIndexByte(self.buf, '\n'); idx >= 0 {
+			self.buf = append(self.buf[:0], self.buf[idx+1:]...)
+		}
+		if len(self.buf) > maxFlagBuffer {
+			self.buf = append(self.buf[:0], self.buf[len(self.buf)-maxFlagBuffer:]...)
+		}
+	}
+	self.lock.Unlock()
+
+	if nil != cb {
+		cb()
+	}
+	return n, err
+}
+
+func compileFlags(name string, flags []string) ([]*regexp.Regexp, error) {
+	var results []*regexp.Regexp
+	for _, s := range flags {
+		if 0 == len(s) {
+			continue
+		}
+		re, e := regexp.Compile(s)
+		if nil != e {
+			return nil, errors.New("'" + name + "' is invalid, " + e.Error())
+		}
+		results = append(results, re)
+	}
+	return results, nil
+}
+
+func regexpStrings(list []*regexp.Regexp) []string {
+	ss := make([]string, len(list))
+	for idx, re := range list {
+		ss[idx] = re.String()
+	}
+	return ss
+}
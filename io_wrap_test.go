@@ -69,3 +69,51 @@ func TestMatch(t *testing.T) {
 		}
 	}
 }
+
+var test_flags = []struct {
+	success []string
+	failure []string
+	data    []string
+	ready   bool
+	failed  bool
+}{{success: []string{"listen (ok|done)"}, data: []string{"listen ok"}, ready: true},
+	{success: []string{"listen (ok|done)"}, data: []string{"list", "en do", "ne"}, ready: true},
+	{success: []string{"listen (ok|done)"}, data: []string{"listen\r\n", "ok"}, ready: false},
+	{success: []string{"^started$", "listen ok"}, data: []string{"abc\n", "liste", "n ok\n"}, ready: true},
+	{success: []string{"(?m)^started$"}, data: []string{"abc\nstar", "ted\n"}, ready: true},
+	{success: []string{"listen ok"}, failure: []string{"Address already in use"}, data: []string{"bind: Address al", "ready in use\n", "listen ok"}, failed: true},
+	{success: []string{"listen ok"}, failure: []string{"OutOfMemoryError"}, data: []string{"listen ok\n", "java.lang.OutOfMemoryError"}, ready: true},
+	{failure: []string{"java\\.lang\\.OutOfMemoryError"}, data: []string{"java.lang.OutOf", "MemoryError"}, failed: true}}
+
+func TestFlagWriter(t *testing.T) {
+	for _, m := range test_flags {
+		success, e := compileFlags("success_flag", m.success)
+		if nil != e {
+			t.Error(e)
+			continue
+		}
+		failure, e := compileFlags("failure_flag", m.failure)
+		if nil != e {
+			t.Error(e)
+			continue
+		}
+
+		ready, failed := false, false
+		var buf bytes.Buffer
+		writer := &flagWriter{out: &buf,
+			success:   success,
+			failure:   failure,
+			onSuccess: func() { ready = true },
+			onFailure: func(string) { failed = true }}
+		for _, p := range m.data {
+			writer.Write([]byte(p))
+		}
+
+		if m.ready != ready || m.failed != failed {
+			t.Errorf("\"%v\" match \"%v\" and \"%v\" failed, excepted is %v/%v, actual is %v/%v",
+				m.data, m.success, m.failure, m.ready, m.failed, ready, failed)
+		} else if strings.Join(m.data, "") != buf.String() {
+			t.Errorf("\"%v\" match \"%v\" failed, result is error", m.data, m.success)
+		}
+	}
+}
@@ -43,7 +43,12 @@ func killProcessAndChildren(pid int, processes []ps.Process) error {
 	return killByPid(pid)
 }
 
+// kill 杀死进程和它的所有子进程， 优先杀死整个进程组， pid 不是组长时
+// 才遍历进程列表按父进程查找子进程。
 func kill(pid int) error {
+	if nil == signalGroup(pid, os.Kill) {
+		return nil
+	}
 	return killProcessAndChildren(pid, nil)
 }
 
@@ -68,6 +73,15 @@ func execWithTimeout(timeout time.Duration, cmd *exec.Cmd) error {
 	return err
 }
 
+// outputWithTimeout 执行命令并返回它的输出
+func outputWithTimeout(timeout time.Duration, cmd *exec.Cmd) ([]byte, error) {
+	var buffer bytes.Buffer
+	cmd.Stdout = &buffer
+	cmd.Stderr = &buffer
+	e := execWithTimeout(timeout, cmd)
+	return bytes.TrimSpace(buffer.Bytes()), e
+}
+
 func processExists(pid int, image string) bool {
 	pr, e := ps.FindProcess(pid)
 	if nil != e {
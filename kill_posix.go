@@ -0,0 +1,92 @@
+//go:build !windows
+// +build !windows
+
+package daemontools
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+var errNotGroupLeader = errors.New("process is not a group leader")
+
+// setProcessGroup 让子进程成为一个新进程组的组长， 这样停止它时可以把它
+// 启动的所有进程(包括已经被 init 接管的孙进程)一起停止。
+func setProcessGroup(cmd *exec.Cmd) {
+	if nil == cmd.SysProcAttr {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.Setpgid = true
+}
+
+// signalGroup 向以 pid 为组长的进程组发送信号， pid 不是组长时返回 errNotGroupLeader
+func signalGroup(pid int, sig os.Signal) error {
+	s, ok := sig.(syscall.Signal)
+	if !ok {
+		return errors.New("signal '" + sig.String() + "' is unsupported")
+	}
+	pgid, e := syscall.Getpgid(pid)
+	if nil != e {
+		return e
+	}
+	if pgid != pid {
+		return errNotGroupLeader
+	}
+	return syscall.Kill(-pgid, s)
+}
+
+// killGroup 杀死进程组 pgid 中的所有进程， 组长已经退出时也有效
+func killGroup(pgid int) error {
+	return signalPgid(pgid, syscall.SIGKILL)
+}
+
+// signalPgid 向进程组 pgid 中的所有进程发送信号， 组长已经退出时也有效
+func signalPgid(pgid int, sig os.Signal) error {
+	if pgid <= 0 {
+		return errors.New("pgid is invalid")
+	}
+	s, ok := sig.(syscall.Signal)
+	if !ok {
+		return errors.New("signal '" + sig.String() + "' is unsupported")
+	}
+	return syscall.Kill(-pgid, s)
+}
+
+// groupExists 判断进程组 pgid 中是否还有进程， 有 /proc 时不包括僵尸进程，
+// 因为容器中的 init 不一定会回收被它接管的进程
+func groupExists(pgid int) bool {
+	if pgid <= 0 || nil != syscall.Kill(-pgid, 0) {
+		return false
+	}
+
+	matches, e := filepath.Glob("/proc/[0-9]*/stat")
+	if nil != e || 0 == len(matches) {
+		return true
+	}
+	for _, file := range matches {
+		bs, e := ioutil.ReadFile(file)
+		if nil != e {
+			continue
+		}
+		idx := bytes.LastIndexByte(bs, ')')
+		if idx < 0 {
+			continue
+		}
+		// ')' 之后依次为 state ppid pgrp
+		fields := strings.Fields(string(bs[idx+1:]))
+		if len(fields) < 3 || "Z" == fields[0] {
+			continue
+		}
+		if strconv.Itoa(pgid) == fields[2] {
+			return true
+		}
+	}
+	return false
+}
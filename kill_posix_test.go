@@ -0,0 +1,208 @@
+//go:build !windows
+// +build !windows
+
+package daemontools
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func isAlive(pid int) bool {
+	if e := syscall.Kill(pid, 0); nil != e {
+		return false
+	}
+	bs, e := ioutil.ReadFile("/proc/" + strconv.Itoa(pid) + "/stat")
+	if nil != e {
+		return true
+	}
+	// 僵尸进程已经退出， 只是还没有被回收
+	idx := bytes.LastIndexByte(bs, ')')
+	return idx < 0 || !bytes.HasPrefix(bytes.TrimSpace(bs[idx+1:]), []byte("Z"))
+}
+
+func TestStopKillsProcessGroup(t *testing.T) {
+	pidFile := filepath.Join(os.TempDir(), "daemontools_orphan.pid")
+	os.Remove(pidFile)
+	defer os.Remove(pidFile)
+
+	// 子 shell 在后台启动的 sleep 会被 init 接管， 按父进程查找是找不到它的
+	var buffer lockedBuffer
+	s := &supervisor_default{restartPolicy: RESTART_NEVER,
+		supervisorBase: supervisorBase{proc_name: "test_group",
+			retries:     1,
+			killTimeout: time.Second,
+			out:         &buffer,
+			start_cmd: &command{proc: "sh",
+				arguments: []string{"-c", "(sleep 1000 & echo $! > " + pidFile + "); sleep 1000"}}}}
+
+	s.start()
+	if e := s.untilStarted(); nil != e {
+		t.Fatal(e)
+	}
+
+	var orphan int
+	for i := 0; i < 100 && 0 == orphan; i++ {
+		time.Sleep(50 * time.Millisecond)
+		bs, _ := ioutil.ReadFile(pidFile)
+		orphan, _ = strconv.Atoi(strings.TrimSpace(string(bs)))
+	}
+	if 0 == orphan {
+		t.Fatal("orphan process is not started -", buffer.String())
+	}
+	defer syscall.Kill(orphan, syscall.SIGKILL)
+
+	s.stop()
+	if e := s.untilStopped(); nil != e {
+		t.Error(e)
+	}
+
+	for i := 0; i < 50 && isAlive(orphan); i++ {
+		time.Sleep(100 * time.Millisecond)
+	}
+	if isAlive(orphan) {
+		t.Error("orphan process", orphan, "is still alive")
+		t.Error(buffer.String())
+	}
+}
+
+func TestStopBySignalSequence(t *testing.T) {
+	for _, sig := range []string{"term", "SIGHUP", "quit", "usr1", "usr2", "15"} {
+		if _, _, e := parseSignal(sig); nil != e {
+			t.Error(e)
+		}
+	}
+
+	var buffer lockedBuffer
+	s := &supervisor_default{restartPolicy: RESTART_NEVER,
+		supervisorBase: supervisorBase{proc_name: "test_signal",
+			retries:     1,
+			killTimeout: 5 * time.Second,
+			out:         &buffer,
+			start_cmd: &command{proc: "sh",
+				arguments: []string{"-c", "trap '' TERM; echo ok; sleep 1000"}},
+			stop_cmd: &command{proc: "__signal__",
+				arguments: []string{"term", "500ms", "kill"}}}}
+
+	s.start()
+	if e := s.untilStarted(); nil != e {
+		t.Fatal(e)
+	}
+	time.Sleep(100 * time.Millisecond)
+
+	started := time.Now()
+	s.stop()
+	if e := s.untilStopped(); nil != e {
+		t.Error(e)
+	}
+	if used := time.Now().Sub(started); used < 500*time.Millisecond || used > 4*time.Second {
+		t.Error("stop used", used)
+	}
+
+	for i := 0; i < 20 && !strings.Contains(buffer.String(), "send SIGKILL"); i++ {
+		time.Sleep(50 * time.Millisecond)
+	}
+	ss := buffer.String()
+	if !strings.Contains(ss, "send SIGTERM") || !strings.Contains(ss, "send SIGKILL") {
+		t.Error(ss)
+	}
+}
+
+func TestEscalateAfterStopCommandFailed(t *testing.T) {
+	var buffer lockedBuffer
+	s := &supervisor_default{restartPolicy: RESTART_NEVER,
+		supervisorBase: supervisorBase{proc_name: "test_escalate",
+			retries:     1,
+			killTimeout: 500 * time.Millisecond,
+			out:         &buffer,
+			start_cmd: &command{proc: "sh",
+				arguments: []string{"-c", "echo ok; sleep 1000"}},
+			stop_cmd: &command{proc: "false"}}}
+
+	s.start()
+	if e := s.untilStarted(); nil != e {
+		t.Fatal(e)
+	}
+	time.Sleep(100 * time.Millisecond)
+
+	s.stop()
+	if e := s.untilStopped(); nil != e {
+		t.Error(e)
+	}
+	// 进程退出后 interrupt() 才写日志， 所以要等一下
+	for i := 0; i < 20 && !strings.Contains(buffer.String(), "send SIGTERM"); i++ {
+		time.Sleep(50 * time.Millisecond)
+	}
+	if ss := buffer.String(); !strings.Contains(ss, "send SIGTERM") || strings.Contains(ss, "kill process when exit") {
+		t.Error(ss)
+	}
+}
+
+func TestStopGroupGracefully(t *testing.T) {
+	for _, test := range []struct {
+		trap     string
+		isKilled bool
+	}{{trap: "echo term > \\$0.out; exit 0", isKilled: false},
+		{trap: "", isKilled: true}} {
+		dir := t.TempDir()
+		pidFile := filepath.Join(dir, "main.pid")
+		outFile := filepath.Join(dir, "child")
+
+		// stop 命令只停止主进程， 子进程留在进程组中
+		var buffer lockedBuffer
+		s := &supervisor_default{restartPolicy: RESTART_NEVER,
+			supervisorBase: supervisorBase{proc_name: "test_stop_group",
+				retries:     1,
+				killTimeout: 500 * time.Millisecond,
+				out:         &buffer,
+				start_cmd: &command{proc: "sh",
+					arguments: []string{"-c", "echo $$ > " + pidFile + "; sh -c \"trap '" + test.trap + "' TERM; while true; do sleep 0.1; done\" " + outFile + " & echo ok; wait"}},
+				stop_cmd: &command{proc: "sh",
+					arguments: []string{"-c", "kill $(cat " + pidFile + ")"}}}}
+
+		s.start()
+		if e := s.untilStarted(); nil != e {
+			t.Fatal(e)
+		}
+		time.Sleep(200 * time.Millisecond)
+		pid := int(s.GetStatus().Pid)
+
+		started := time.Now()
+		s.stop()
+		if e := s.untilStopped(); nil != e {
+			t.Error(e)
+		}
+		for i := 0; i < 50 && groupExists(pid); i++ {
+			time.Sleep(100 * time.Millisecond)
+		}
+		if groupExists(pid) {
+			syscall.Kill(-pid, syscall.SIGKILL)
+			t.Fatal("process group", pid, "is still alive -", buffer.String())
+		}
+		used := time.Now().Sub(started)
+
+		ss := buffer.String()
+		if !strings.Contains(ss, "send SIGTERM to the rest of process group") {
+			t.Error(ss)
+		}
+		if test.isKilled {
+			if !strings.Contains(ss, "kill the rest of process group") || used < 500*time.Millisecond {
+				t.Error(used, ss)
+			}
+		} else {
+			if strings.Contains(ss, "kill the rest of process group") {
+				t.Error(ss)
+			}
+			if bs, _ := ioutil.ReadFile(outFile + ".out"); "term\n" != string(bs) {
+				t.Errorf("%q", bs)
+			}
+		}
+	}
+}
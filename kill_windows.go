@@ -0,0 +1,28 @@
+package daemontools
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+)
+
+var errProcessGroupUnsupported = errors.New("process group is unsupported on windows")
+
+func setProcessGroup(cmd *exec.Cmd) {
+}
+
+func signalGroup(pid int, sig os.Signal) error {
+	return errProcessGroupUnsupported
+}
+
+func killGroup(pgid int) error {
+	return errProcessGroupUnsupported
+}
+
+func signalPgid(pgid int, sig os.Signal) error {
+	return errProcessGroupUnsupported
+}
+
+func groupExists(pgid int) bool {
+	return false
+}
@@ -8,7 +8,9 @@ import (
 	"log"
 	"net/http"
 	"regexp"
+	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/rakyll/statik/fs"
 	"github.com/runner-mei/cron"
@@ -45,6 +47,19 @@ type Manager struct {
 	protected        []string
 	fs               http.Handler
 	on               func(string, int32)
+	alert            func(string, string)
+
+	rollingLock sync.Mutex
+	rolling     map[string]bool
+
+	// load 重新读取配置文件， 用于重新加载配置
+	load       func() (map[string]interface{}, []supervisor, error)
+	reloadLock sync.Mutex
+
+	// lock 保护 supervisors 和 settings， 重新加载配置时它们会被替换
+	lock sync.RWMutex
+
+	settingsLock sync.Mutex
 }
 
 func (self *Manager) On(on func(string, int32)) {
@@ -57,6 +72,18 @@ func (self *Manager) onEvent(name string, status int32) {
 	}
 }
 
+// OnAlert 设置告警的回调， 如资源使用超过了阈值， 参数为服务名和告警的内容
+func (self *Manager) OnAlert(alert func(name, message string)) {
+	self.alert = alert
+}
+
+func (self *Manager) onAlert(name, message string) {
+	log.Println("[alert] '" + name + "' " + message)
+	if self.alert != nil {
+		self.alert(name, message)
+	}
+}
+
 func (self *Manager) SetFs(fs http.Handler) {
 	self.fs = fs
 }
@@ -65,17 +92,31 @@ func (self *Manager) SetMode(mode string) {
 	self.mode = mode
 }
 
+func (self *Manager) list() []supervisor {
+	self.lock.RLock()
+	defer self.lock.RUnlock()
+	return self.supervisors
+}
+
+func (self *Manager) snapshot() ([]supervisor, map[string]interface{}) {
+	self.lock.RLock()
+	defer self.lock.RUnlock()
+	return self.supervisors, self.settings
+}
+
 func (self *Manager) Names() []string {
-	names := make([]string, len(self.supervisors))
-	for idx, s := range self.supervisors {
+	supervisors := self.list()
+	names := make([]string, len(supervisors))
+	for idx, s := range supervisors {
 		names[idx] = s.name()
 	}
 	return names
 }
 
 func (self *Manager) GetStatus() []Status {
-	names := make([]Status, len(self.supervisors))
-	for idx, s := range self.supervisors {
+	supervisors := self.list()
+	names := make([]Status, len(supervisors))
+	for idx, s := range supervisors {
 		names[idx] = s.GetStatus()
 	}
 	return names
@@ -85,17 +126,138 @@ func (self *Manager) Mode() string {
 	return self.mode
 }
 
+// lookup 按名称查找服务， 名称为实例组名时返回组中所有的实例
+func (self *Manager) lookup(name string) []supervisor {
+	var results []supervisor
+	for _, sp := range self.list() {
+		if sp.name() == name {
+			return []supervisor{sp}
+		}
+		if sp.instanceOf() == name {
+			results = append(results, sp)
+		}
+	}
+	return results
+}
+
 func (self *Manager) restart(name string) error {
 	log.Println("[system] restart '" + name + "'")
-	for _, sp := range self.supervisors {
+	list := self.lookup(name)
+	if 0 == len(list) {
+		return errors.New(name + " isn't found.")
+	}
+	for _, sp := range list {
+		sp.stop()
+		sp.start()
+	}
+	return nil
+}
+
+// members 返回属于 group 的所有服务， 包括实例组中的实例和 groups 中含有 group 的服务
+func (self *Manager) members(group string) []supervisor {
+	var results []supervisor
+	for _, sp := range self.list() {
+		if sp.memberOf(group) {
+			results = append(results, sp)
+		}
+	}
+	return results
+}
+
+var errRollingRestart = errors.New("rolling restart is already in progress.")
+
+// rollingReport 是一次滚动重启的结果， 有服务失败时 Failed 和 Error 为失败的
+// 服务及原因， Pending 为还没有重启的服务。
+type rollingReport struct {
+	Group     string   `json:"group"`
+	Restarted []string `json:"restarted"`
+	Skipped   []string `json:"skipped,omitempty"`
+	Failed    string   `json:"failed,omitempty"`
+	Error     string   `json:"error,omitempty"`
+	Pending   []string `json:"pending,omitempty"`
+}
+
+// rollingRestart 逐个重启 group 中的服务， 一个服务启动成功 (配置了 ready 时要等到
+// 它就绪) 后才重启下一个， 有服务失败时暂停， 剩下的服务不会被重启。
+func (self *Manager) rollingRestart(group string) (*rollingReport, error) {
+	list := self.members(group)
+	if 0 == len(list) {
+		return nil, errors.New(group + " isn't found.")
+	}
+
+	self.rollingLock.Lock()
+	if self.rolling[group] {
+		self.rollingLock.Unlock()
+		return nil, errRollingRestart
+	}
+	if nil == self.rolling {
+		self.rolling = map[string]bool{}
+	}
+	self.rolling[group] = true
+	self.rollingLock.Unlock()
+
+	defer func() {
+		self.rollingLock.Lock()
+		delete(self.rolling, group)
+		self.rollingLock.Unlock()
+	}()
+
+	log.Println("[system] rolling restart '" + group + "'")
+	report := &rollingReport{Group: group, Restarted: []string{}}
+	for idx, sp := range list {
+		if self.IsSipped(sp.name()) {
+			report.Skipped = append(report.Skipped, sp.name())
+			continue
+		}
+
+		e := self.restart(sp.name())
+		if nil == e {
+			e = sp.untilStarted()
+		}
+		if nil != e {
+			report.Failed = sp.name()
+			report.Error = e.Error()
+			for _, pending := range list[idx+1:] {
+				report.Pending = append(report.Pending, pending.name())
+			}
+			log.Println("[system] rolling restart '" + group + "' is paused, restart '" + sp.name() + "' failed, " + e.Error())
+			return report, nil
+		}
+		report.Restarted = append(report.Restarted, sp.name())
+	}
+	log.Println("[system] rolling restart '" + group + "' is ok.")
+	return report, nil
+}
+
+// reload 让服务重新加载配置， 它不会改变服务的状态， 也不算一次重启
+func (self *Manager) reload(name string) error {
+	log.Println("[system] reload '" + name + "'")
+	list := self.lookup(name)
+	if 0 == len(list) {
+		return errors.New(name + " isn't found.")
+	}
+	var errList []string
+	for _, sp := range list {
+		if e := sp.reload(); nil != e {
+			errList = append(errList, e.Error())
+		}
+	}
+	if 0 != len(errList) {
+		return errors.New(strings.Join(errList, "\r\n"))
+	}
+	return nil
+}
+
+var errNotOwned = errors.New("process is not owned by daemontools.")
+
+func (self *Manager) signal(name, sig string, group bool) (string, error) {
+	log.Println("[system] signal '" + name + "' with '" + sig + "'")
+	for _, sp := range self.list() {
 		if sp.name() == name {
-			sp.stop()
-			sp.start()
-			return nil
+			return sp.signal(sig, group)
 		}
 	}
-	log.Println("[system] kill '" + name + "'")
-	return errors.New(name + " isn't found.")
+	return "", errors.New(name + " isn't found.")
 }
 
 func (self *Manager) StartByName(name string) error {
@@ -105,13 +267,15 @@ func (self *Manager) StartByName(name string) error {
 func (self *Manager) start(name string) error {
 	self.Enable(name)
 	log.Println("[system] enable '" + name + "'")
-	for _, sp := range self.supervisors {
-		if sp.name() == name {
-			sp.start()
-			return nil
-		}
+	list := self.lookup(name)
+	if 0 == len(list) {
+		return errors.New(name + " isn't found.")
 	}
-	return errors.New(name + " isn't found.")
+	self.saveDisabled(name, list, false)
+	for _, sp := range list {
+		sp.start()
+	}
+	return nil
 }
 
 func (self *Manager) StopByName(name string) error {
@@ -121,22 +285,52 @@ func (self *Manager) StopByName(name string) error {
 func (self *Manager) stop(name string) error {
 	self.Disable(name)
 
-	for _, sp := range self.supervisors {
-		if sp.name() == name {
-			if sp.stop() {
-				log.Println("[system] disable '" + name + "' ok.")
-			}
-			return nil
+	list := self.lookup(name)
+	if 0 != len(list) {
+		self.saveDisabled(name, list, true)
+	}
+	for _, sp := range list {
+		if sp.stop() {
+			log.Println("[system] disable '" + sp.name() + "' ok.")
 		}
 	}
+	if 0 != len(list) {
+		return nil
+	}
 	log.Println("[system] disable '" + name + "' fail, no found.")
 	return errors.New(name + " isn't found.")
 }
 
+// saveDisabled 将 <name>.disabled 保存到 settings_file 中， 这样 daemontools
+// 重启后服务仍然保持启用或禁用的状态， name 为实例组名时组中所有的实例一起保存。
+func (self *Manager) saveDisabled(name string, list []supervisor, disabled bool) {
+	if "" == self.settings_file {
+		return
+	}
+
+	value := strconv.FormatBool(disabled)
+	values := map[string]string{name + ".disabled": value}
+	for _, sp := range list {
+		values[sp.name()+".disabled"] = value
+	}
+
+	self.settingsLock.Lock()
+	defer self.settingsLock.Unlock()
+	if e := writeProperties(self.settings_file, values); nil != e {
+		log.Println("[system] save '"+name+".disabled' to '"+self.settings_file+"' failed,", e)
+	}
+}
+
+// Enable 和 Disable 的名称为实例组名时对组中所有的实例生效
 func (self *Manager) Enable(name string) {
+	names := map[string]bool{name: true}
+	for _, sp := range self.lookup(name) {
+		names[sp.name()] = true
+	}
+
 	skipped := make([]string, 0, len(self.skipped))
 	for _, nm := range self.skipped {
-		if nm == name {
+		if names[nm] {
 			continue
 		}
 		skipped = append(skipped, nm)
@@ -146,6 +340,11 @@ func (self *Manager) Enable(name string) {
 
 func (self *Manager) Disable(name string) {
 	self.skipped = append(self.skipped, name)
+	for _, sp := range self.lookup(name) {
+		if sp.name() != name {
+			self.skipped = append(self.skipped, sp.name())
+		}
+	}
 }
 
 func (self *Manager) IsSipped(name string) bool {
@@ -189,8 +388,9 @@ func (self *Manager) IsProtected(name string) bool {
 }
 
 func (self *Manager) Stats() interface{} {
-	res := make([]interface{}, 0, len(self.supervisors))
-	for _, s := range self.supervisors {
+	supervisors, arguments := self.snapshot()
+	res := make([]interface{}, 0, len(supervisors))
+	for _, s := range supervisors {
 		values := s.stats()
 		if self.IsSipped(s.name()) {
 			values["is_started"] = false
@@ -199,7 +399,7 @@ func (self *Manager) Stats() interface{} {
 		res = append(res, values)
 	}
 	settings := map[string]string{}
-	for key, value := range self.settings {
+	for key, value := range arguments {
 		settings[key] = fmt.Sprint(value)
 	}
 	return map[string]interface{}{"processes": res,
@@ -238,29 +438,24 @@ func (self *Manager) Start() error {
 }
 
 func (self *Manager) Restore() error {
-	for _, s := range self.supervisors {
+	var startList []supervisor
+	for _, s := range self.list() {
 		if self.IsSipped(s.name()) {
 			continue
 		}
 		if !s.isMode(self.mode) {
 			continue
 		}
-		s.start()
+		startList = append(startList, s)
 	}
 
-	var failed []string
-	for _, s := range self.supervisors {
-		if self.IsSipped(s.name()) {
-			continue
-		}
-
-		if !s.isMode(self.mode) {
-			continue
-		}
+	failed := runByDependencies(startList, false, func(s supervisor) error {
+		s.start()
 		if e := s.untilStarted(); nil != e {
-			failed = append(failed, fmt.Sprintf("start '%v' failed, %v", s.name(), e))
+			return fmt.Errorf("start '%v' failed, %v", s.name(), e)
 		}
-	}
+		return nil
+	})
 	if len(failed) > 0 {
 		return errors.New(strings.Join(failed, "\r\n"))
 	}
@@ -273,43 +468,22 @@ func (self *Manager) Pause() error {
 
 func (self *Manager) stopAll(all bool) error {
 	var stopList []supervisor
-	for _, s := range self.supervisors {
-		//if self.IsSipped(s.name()) {
-		//	continue
-		//}
-
+	for _, s := range self.list() {
 		if !all {
 			if self.IsProtected(s.name()) {
 				continue
 			}
 		}
-
-		//if !s.isMode(self.mode) {
-		//	continue
-		//}
-		s.stop()
 		stopList = append(stopList, s)
 	}
 
-	var failed []string
-	for _, s := range stopList {
-		// if self.IsSipped(s.name()) {
-		// 	continue
-		// }
-		// if !all {
-		// 	fmt.Println("====", s.name(), "?")
-		// 	if self.IsProtected(s.name()) {
-		// 		continue
-		// 	}
-		// }
-		// if !s.isMode(self.mode) {
-		// 	continue
-		// }
-
+	failed := runByDependencies(stopList, true, func(s supervisor) error {
+		s.stop()
 		if err := s.untilStopped(); nil != err {
-			failed = append(failed, fmt.Sprintf("start '%v' failed, %v", s.name(), err))
+			return fmt.Errorf("stop '%v' failed, %v", s.name(), err)
 		}
-	}
+		return nil
+	})
 	if len(failed) > 0 {
 		return errors.New(strings.Join(failed, "\r\n"))
 	}
@@ -332,6 +506,7 @@ func (self *Manager) RunForever(listenAddress string) {
 		goto end
 	}
 	http.Handle("/", self)
+	self.reloadOnSignal()
 	log.Println("[daemontools] serving at '" + listenAddress + "'")
 	if e := http.ListenAndServe(listenAddress, nil); nil != e {
 		log.Println("[daemontools] fail to listen at '"+listenAddress+"'", e)
@@ -373,6 +548,20 @@ func (self *Manager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	case "POST":
 		ss := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+		if 1 == len(ss) && "reload" == strings.ToLower(ss[0]) {
+			report, e := self.reloadConfigs()
+			if nil != e {
+				w.WriteHeader(http.StatusInternalServerError)
+				io.WriteString(w, e.Error())
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			if e := json.NewEncoder(w).Encode(report); nil != e {
+				log.Println(e)
+			}
+			return
+		}
 		if 2 == len(ss) {
 			switch strings.ToLower(ss[1]) {
 			case "restart":
@@ -384,6 +573,15 @@ func (self *Manager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					io.WriteString(w, "OK")
 				}
 				return
+			case "reload":
+				if e := self.reload(ss[0]); nil != e {
+					w.WriteHeader(http.StatusInternalServerError)
+					io.WriteString(w, e.Error())
+				} else {
+					w.WriteHeader(http.StatusOK)
+					io.WriteString(w, "OK")
+				}
+				return
 			case "start":
 				if e := self.start(ss[0]); nil != e {
 					w.WriteHeader(http.StatusInternalServerError)
@@ -403,6 +601,42 @@ func (self *Manager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				}
 				return
 			}
+		} else if 3 == len(ss) && "groups" == ss[0] && "rolling-restart" == strings.ToLower(ss[2]) {
+			report, e := self.rollingRestart(ss[1])
+			if nil != e {
+				if e == errRollingRestart {
+					w.WriteHeader(http.StatusConflict)
+				} else {
+					w.WriteHeader(http.StatusNotFound)
+				}
+				io.WriteString(w, e.Error())
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			if "" != report.Failed {
+				w.WriteHeader(http.StatusInternalServerError)
+			} else {
+				w.WriteHeader(http.StatusOK)
+			}
+			if e := json.NewEncoder(w).Encode(report); nil != e {
+				log.Println(e)
+			}
+			return
+		} else if 3 == len(ss) && "signal" == strings.ToLower(ss[1]) {
+			group := "true" == strings.ToLower(r.URL.Query().Get("group"))
+			txt, e := self.signal(ss[0], ss[2], group)
+			if nil != e {
+				if e == errNotOwned {
+					w.WriteHeader(http.StatusForbidden)
+				} else {
+					w.WriteHeader(http.StatusInternalServerError)
+				}
+				io.WriteString(w, e.Error())
+			} else {
+				w.WriteHeader(http.StatusOK)
+				io.WriteString(w, txt)
+			}
+			return
 		}
 	}
 
@@ -0,0 +1,102 @@
+//go:build !windows
+// +build !windows
+
+package daemontools
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func newRollingSupervisor(out *lockedBuffer, name string, idx int, script string) *supervisor_default {
+	return &supervisor_default{success_flag: "ok",
+		supervisorBase: supervisorBase{proc_name: name + "-" + strconv.Itoa(idx),
+			group:       name,
+			instance:    idx,
+			retries:     1,
+			killTimeout: time.Second,
+			out:         out,
+			start_cmd:   &command{proc: "sh", arguments: []string{"-c", script}}}}
+}
+
+func TestRollingRestart(t *testing.T) {
+	var buffer lockedBuffer
+	var supervisors []supervisor
+	for i := 0; i < 3; i++ {
+		supervisors = append(supervisors, newRollingSupervisor(&buffer, "worker", i,
+			"echo ok; while true; do sleep 0.1; done"))
+	}
+	other := &supervisor_default{supervisorBase: supervisorBase{proc_name: "other", groups: []string{"web"}}}
+	mgr := &Manager{supervisors: append(supervisors, other)}
+	defer mgr.stopAll(true)
+
+	for _, sp := range supervisors {
+		sp.start()
+		if e := sp.untilStarted(); nil != e {
+			t.Fatal(e)
+		}
+	}
+	var pids []int64
+	for _, sp := range supervisors {
+		pids = append(pids, sp.GetStatus().Pid)
+	}
+
+	rec := httptest.NewRecorder()
+	mgr.ServeHTTP(rec, httptest.NewRequest("POST", "/groups/worker/rolling-restart", nil))
+	if http.StatusOK != rec.Code {
+		t.Fatal(rec.Code, rec.Body.String())
+	}
+	var report rollingReport
+	if e := json.Unmarshal(rec.Body.Bytes(), &report); nil != e {
+		t.Fatal(e)
+	}
+	if 3 != len(report.Restarted) || "worker-0" != report.Restarted[0] || "worker-2" != report.Restarted[2] {
+		t.Error(report)
+	}
+	for idx, sp := range supervisors {
+		if pid := sp.GetStatus().Pid; 0 == pid || pids[idx] == pid {
+			t.Error(sp.name(), "is not restarted, pid is", pid)
+		}
+	}
+
+	if 1 != len(mgr.members("web")) {
+		t.Error("excepted 1 member of web, actual is", len(mgr.members("web")))
+	}
+
+	rec = httptest.NewRecorder()
+	mgr.ServeHTTP(rec, httptest.NewRequest("POST", "/groups/not_exists/rolling-restart", nil))
+	if http.StatusNotFound != rec.Code {
+		t.Error(rec.Code, rec.Body.String())
+	}
+}
+
+func TestRollingRestartPausedOnFailure(t *testing.T) {
+	var buffer lockedBuffer
+	supervisors := []supervisor{
+		newRollingSupervisor(&buffer, "worker", 0, "echo ok; while true; do sleep 0.1; done"),
+		newRollingSupervisor(&buffer, "worker", 1, "echo failed; exit 1"),
+		newRollingSupervisor(&buffer, "worker", 2, "echo ok; while true; do sleep 0.1; done")}
+	mgr := &Manager{supervisors: supervisors}
+	defer mgr.stopAll(true)
+
+	report, e := mgr.rollingRestart("worker")
+	if nil != e {
+		t.Fatal(e)
+	}
+	if "worker-1" != report.Failed || "" == report.Error {
+		t.Error(report)
+	}
+	if 1 != len(report.Restarted) || "worker-0" != report.Restarted[0] {
+		t.Error(report)
+	}
+	if 1 != len(report.Pending) || "worker-2" != report.Pending[0] {
+		t.Error(report)
+	}
+	if status := supervisors[2].GetStatus(); 0 != status.Pid {
+		t.Error("worker-2 is restarted after worker-1 is failed")
+	}
+}
@@ -0,0 +1,132 @@
+package daemontools
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+var errMetricsUnsupported = errors.New("metrics is unsupported on this platform")
+
+// processSample 是一次采样的结果， 包括主进程和它的所有子孙进程
+type processSample struct {
+	rss     uint64
+	vms     uint64
+	cpuTime time.Duration
+	threads int
+	fds     int
+	procs   int
+}
+
+type processMetrics struct {
+	sync.Mutex
+	sample     processSample
+	cpuPercent float64
+	sampledAt  time.Time
+}
+
+func (self *processMetrics) reset() {
+	self.Lock()
+	defer self.Unlock()
+	self.sample = processSample{}
+	self.cpuPercent = 0
+	self.sampledAt = time.Time{}
+}
+
+// update 保存一次采样并返回 cpu 使用率， cpu 使用率是相对于上一次采样计算的
+func (self *processMetrics) update(sample processSample, now time.Time) float64 {
+	self.Lock()
+	defer self.Unlock()
+	if !self.sampledAt.IsZero() {
+		elapsed := now.Sub(self.sampledAt)
+		if elapsed > 0 && sample.cpuTime >= self.sample.cpuTime {
+			self.cpuPercent = float64(sample.cpuTime-self.sample.cpuTime) * 100 / float64(elapsed)
+		} else {
+			self.cpuPercent = 0
+		}
+	}
+	self.sample = sample
+	self.sampledAt = now
+	return self.cpuPercent
+}
+
+func (self *processMetrics) stats() map[string]interface{} {
+	self.Lock()
+	defer self.Unlock()
+	if self.sampledAt.IsZero() {
+		return nil
+	}
+	return map[string]interface{}{
+		"rss":         self.sample.rss,
+		"rss_text":    formatBytes(self.sample.rss),
+		"vms":         self.sample.vms,
+		"vms_text":    formatBytes(self.sample.vms),
+		"cpu_percent": float64(int64(self.cpuPercent*100)) / 100,
+		"threads":     self.sample.threads,
+		"fds":         self.sample.fds,
+		"procs":       self.sample.procs,
+		"sampled_at":  self.sampledAt,
+	}
+}
+
+func formatBytes(n uint64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%dB", n)
+	}
+	div, exp := uint64(unit), 0
+	for v := n / unit; v >= unit; v /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%cB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+// metricsLoop 按 metricsInterval 周期性的采集进程的资源使用情况，
+// 进程退出时 done 会被关闭。
+func (self *supervisor_default) metricsLoop(done <-chan struct{}, pid int) {
+	self.metrics.reset()
+	defer self.metrics.reset()
+	if nil != self.thresholds {
+		self.thresholds.reset()
+	}
+
+	ticker := time.NewTicker(self.metricsInterval)
+	defer ticker.Stop()
+
+	for {
+		sample, e := sampleProcessTree(pid)
+		if nil != e {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			if e != errMetricsUnsupported {
+				self.logString(fmt.Sprintf("[sys] sample metrics of pid('%v') failed - %v\r\n", pid, e))
+			}
+			return
+		}
+		now := time.Now()
+		cpuPercent := self.metrics.update(sample, now)
+		if nil != self.thresholds {
+			rules, messages := self.thresholds.check(pid, sample, cpuPercent, now)
+			for idx, rule := range rules {
+				self.logString("[sys] threshold '" + rule.text + "' is exceeded - " + messages[idx] + "\r\n")
+				self.onAlert("threshold '" + rule.text + "' is exceeded - " + messages[idx])
+				if THRESHOLD_RESTART == rule.action {
+					self.logString("[sys] restart it.\r\n")
+					self.interruptWith(EXIT_REASON_THRESHOLD, messages[idx])
+					return
+				}
+			}
+		}
+
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package daemontools
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 内核中 USER_HZ 在所有常见的平台上都是 100
+const clockTicks = 100
+
+type procStat struct {
+	ppid    int
+	cpuTime time.Duration
+	threads int
+	vms     uint64
+	rss     uint64
+}
+
+func readProcStat(pid int) (procStat, error) {
+	var st procStat
+	bs, e := ioutil.ReadFile("/proc/" + strconv.Itoa(pid) + "/stat")
+	if nil != e {
+		return st, e
+	}
+
+	// 进程名中可能有空格或括号， 所以从最后一个 ')' 开始解析
+	s := string(bs)
+	idx := strings.LastIndexByte(s, ')')
+	if idx < 0 {
+		return st, errors.New("'/proc/" + strconv.Itoa(pid) + "/stat' is invalid.")
+	}
+	fields := strings.Fields(s[idx+1:])
+	if len(fields) < 22 {
+		return st, errors.New("'/proc/" + strconv.Itoa(pid) + "/stat' is invalid.")
+	}
+
+	st.ppid, _ = strconv.Atoi(fields[1])
+	utime, _ := strconv.ParseUint(fields[11], 10, 64)
+	stime, _ := strconv.ParseUint(fields[12], 10, 64)
+	st.cpuTime = time.Duration(utime+stime) * time.Second / clockTicks
+	st.threads, _ = strconv.Atoi(fields[17])
+	st.vms, _ = strconv.ParseUint(fields[20], 10, 64)
+	rss, _ := strconv.ParseInt(fields[21], 10, 64)
+	if rss > 0 {
+		st.rss = uint64(rss) * uint64(os.Getpagesize())
+	}
+	return st, nil
+}
+
+func countFds(pid int) int {
+	d, e := os.Open("/proc/" + strconv.Itoa(pid) + "/fd")
+	if nil != e {
+		return 0
+	}
+	defer d.Close()
+	names, _ := d.Readdirnames(-1)
+	return len(names)
+}
+
+// sampleProcessTree 从 /proc 中读取 pid 及它的所有子孙进程的资源使用情况
+func sampleProcessTree(pid int) (processSample, error) {
+	var sample processSample
+	root, e := readProcStat(pid)
+	if nil != e {
+		return sample, e
+	}
+
+	d, e := os.Open("/proc")
+	if nil != e {
+		return sample, e
+	}
+	names, e := d.Readdirnames(-1)
+	d.Close()
+	if nil != e {
+		return sample, e
+	}
+
+	stats := map[int]procStat{pid: root}
+	children := map[int][]int{}
+	for _, nm := range names {
+		child, e := strconv.Atoi(nm)
+		if nil != e || child == pid {
+			continue
+		}
+		st, e := readProcStat(child)
+		if nil != e {
+			continue
+		}
+		stats[child] = st
+		children[st.ppid] = append(children[st.ppid], child)
+	}
+
+	queue := []int{pid}
+	for 0 != len(queue) {
+		current := queue[0]
+		queue = queue[1:]
+		queue = append(queue, children[current]...)
+
+		st := stats[current]
+		sample.rss += st.rss
+		sample.vms += st.vms
+		sample.cpuTime += st.cpuTime
+		sample.threads += st.threads
+		sample.fds += countFds(current)
+		sample.procs++
+	}
+	return sample, nil
+}
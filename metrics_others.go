@@ -0,0 +1,8 @@
+//go:build !linux
+// +build !linux
+
+package daemontools
+
+func sampleProcessTree(pid int) (processSample, error) {
+	return processSample{}, errMetricsUnsupported
+}
@@ -0,0 +1,47 @@
+package daemontools
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestProcessMetrics(t *testing.T) {
+	var metrics processMetrics
+	if nil != metrics.stats() {
+		t.Error("stats must is nil before sampled")
+	}
+
+	now := time.Now()
+	metrics.update(processSample{rss: 3 * 1024 * 1024, cpuTime: 1 * time.Second, procs: 2}, now)
+	metrics.update(processSample{rss: 3 * 1024 * 1024, cpuTime: 1500 * time.Millisecond, procs: 2}, now.Add(2*time.Second))
+
+	stats := metrics.stats()
+	if 25.0 != stats["cpu_percent"] {
+		t.Error("cpu_percent is", stats["cpu_percent"])
+	}
+	if "3.0MB" != stats["rss_text"] {
+		t.Error("rss_text is", stats["rss_text"])
+	}
+	if 2 != stats["procs"] {
+		t.Error("procs is", stats["procs"])
+	}
+
+	metrics.reset()
+	if nil != metrics.stats() {
+		t.Error("stats must is nil after reset")
+	}
+}
+
+func TestSampleProcessTree(t *testing.T) {
+	sample, e := sampleProcessTree(os.Getpid())
+	if e == errMetricsUnsupported {
+		t.Skip(e)
+	}
+	if nil != e {
+		t.Fatal(e)
+	}
+	if sample.procs < 1 || 0 == sample.rss || 0 == sample.threads || 0 == sample.fds {
+		t.Errorf("%#v", sample)
+	}
+}
@@ -0,0 +1,89 @@
+package daemontools
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"syscall"
+)
+
+const (
+	preexecEnv     = "DAEMONTOOLS_EXEC"
+	rlimitsEnv     = "DAEMONTOOLS_RLIMITS"
+	cgroupEnv      = "DAEMONTOOLS_CGROUP"
+	credentialEnv  = "DAEMONTOOLS_CREDENTIAL"
+	preexecBinPath = "/proc/self/exe"
+	preexecArg0    = "daemontools-preexec"
+)
+
+// 标准库无法在 fork 之后 exec 之前对子进程做设置(如资源限制和 cgroup)，
+// 所以子进程先以本程序启动， 在这里设置好后再 exec 成真正的程序， pid 不会改变。
+//
+// 只有 argv[0] 正好是 preexecArg0 并且有环境变量 DAEMONTOOLS_EXEC 时才会进入，
+// 此时 DAEMONTOOLS_EXEC 为真正的程序， argv[1:] 为它的参数(含 argv[0])， 这两个
+// 条件都只由 preexec() 设置， 所以引用本包的其它程序不会因为继承了环境变量而被替换。
+func init() {
+	path := os.Getenv(preexecEnv)
+	if "" == path || len(os.Args) < 2 || preexecArg0 != os.Args[0] {
+		return
+	}
+
+	var env = make([]string, 0, len(os.Environ()))
+	for _, s := range os.Environ() {
+		if strings.HasPrefix(s, preexecEnv+"=") ||
+			strings.HasPrefix(s, rlimitsEnv+"=") ||
+			strings.HasPrefix(s, cgroupEnv+"=") ||
+			strings.HasPrefix(s, credentialEnv+"=") {
+			continue
+		}
+		env = append(env, s)
+	}
+
+	if cgroup := os.Getenv(cgroupEnv); "" != cgroup {
+		if e := joinCgroup(cgroup, os.Getpid()); nil != e {
+			fmt.Fprintln(os.Stderr, "[sys] join cgroup failed -", e)
+			os.Exit(126)
+		}
+	}
+	if spec := os.Getenv(rlimitsEnv); "" != spec {
+		if e := setRlimits(spec); nil != e {
+			fmt.Fprintln(os.Stderr, "[sys] set limits failed -", e)
+			os.Exit(126)
+		}
+	}
+	if spec := os.Getenv(credentialEnv); "" != spec {
+		if e := setCredential(spec); nil != e {
+			fmt.Fprintln(os.Stderr, "[sys] set user and group failed -", e)
+			os.Exit(126)
+		}
+	}
+
+	e := syscall.Exec(path, os.Args[1:], env)
+	fmt.Fprintln(os.Stderr, "[sys] exec '"+path+"' failed -", e)
+	os.Exit(127)
+}
+
+// preexec 让 cmd 先以本程序启动， 并通过环境变量 key 传递 value 给 init()
+func preexec(cmd *exec.Cmd, key, value string) {
+	if nil == cmd.Env {
+		cmd.Env = os.Environ()
+	}
+	if preexecBinPath != cmd.Path {
+		if 0 == len(cmd.Args) {
+			cmd.Args = []string{cmd.Path}
+		}
+		cmd.Env = append(cmd.Env, preexecEnv+"="+cmd.Path)
+		cmd.Args = append([]string{preexecArg0}, cmd.Args...)
+		cmd.Path = preexecBinPath
+
+		// 用户和组必须在设置资源限制和 cgroup 之后才切换
+		if nil != cmd.SysProcAttr && nil != cmd.SysProcAttr.Credential {
+			c := cmd.SysProcAttr.Credential
+			cmd.SysProcAttr.Credential = nil
+			cred := &credential{uid: c.Uid, gid: c.Gid, groups: c.Groups}
+			cmd.Env = append(cmd.Env, credentialEnv+"="+cred.encode())
+		}
+	}
+	cmd.Env = append(cmd.Env, key+"="+value)
+}
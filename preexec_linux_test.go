@@ -0,0 +1,30 @@
+package daemontools
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+func TestPreexec(t *testing.T) {
+	cmd := exec.Command("sh", "-c", "echo $0 $1", "abc", "123")
+	preexec(cmd, rlimitsEnv, "")
+	if preexecBinPath != cmd.Path || preexecArg0 != cmd.Args[0] {
+		t.Fatal(cmd.Path, cmd.Args)
+	}
+	output, e := cmd.CombinedOutput()
+	if nil != e {
+		t.Fatal(e, string(output))
+	}
+	if s := strings.TrimSpace(string(output)); "abc 123" != s {
+		t.Error(s)
+	}
+
+	// 只有环境变量时不会 exec， 测试程序正常运行并退出
+	cmd = exec.Command(os.Args[0], "-test.run=^$")
+	cmd.Env = append(os.Environ(), preexecEnv+"=/bin/false")
+	if output, e := cmd.CombinedOutput(); nil != e {
+		t.Error(e, string(output))
+	}
+}
@@ -0,0 +1,113 @@
+package daemontools
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	PROBE_HTTP = "http"
+	PROBE_TCP  = "tcp"
+	PROBE_EXEC = "exec"
+	PROBE_FILE = "file"
+)
+
+type probe struct {
+	typ     string
+	url     string
+	address string
+	path    string
+	cmd     *command
+	timeout time.Duration
+}
+
+func (self *probe) String() string {
+	switch self.typ {
+	case PROBE_HTTP:
+		return "http " + self.url
+	case PROBE_TCP:
+		return "tcp " + self.address
+	case PROBE_EXEC:
+		return "exec " + self.cmd.proc
+	case PROBE_FILE:
+		return "file " + self.path
+	}
+	return self.typ
+}
+
+// fingerprint 返回探测的实际配置， 用于计算配置的摘要
+func (self *probe) fingerprint() []interface{} {
+	return []interface{}{self.typ, self.url, self.address, self.path, self.cmd.fingerprint(), self.timeout}
+}
+
+func (self *probe) check(mode string) error {
+	switch self.typ {
+	case PROBE_HTTP:
+		client := &http.Client{Timeout: self.timeout}
+		resp, e := client.Get(self.url)
+		if nil != e {
+			return e
+		}
+		resp.Body.Close()
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return errors.New("GET " + self.url + " return '" + resp.Status + "'")
+		}
+		return nil
+	case PROBE_TCP:
+		conn, e := net.DialTimeout("tcp", self.address, self.timeout)
+		if nil != e {
+			return e
+		}
+		conn.Close()
+		return nil
+	case PROBE_EXEC:
+		return execWithTimeout(self.timeout, self.cmd.command(mode))
+	case PROBE_FILE:
+		_, e := os.Stat(self.path)
+		return e
+	}
+	return errors.New("probe '" + self.typ + "' is unsupported")
+}
+
+func loadProbe(name string, args []map[string]interface{}, timeoutKey string, defaultTimeout time.Duration) (*probe, error) {
+	p := &probe{typ: strings.ToLower(stringWithDefault(args[0], "type", "")),
+		timeout: durationWithDefault(args[0], timeoutKey, defaultTimeout)}
+	if p.timeout <= 0 {
+		return nil, fmt.Errorf("'%s.%s' must is greate 0s.", name, timeoutKey)
+	}
+
+	switch p.typ {
+	case PROBE_HTTP:
+		p.url = stringWithDefault(args[0], "url", "")
+		if "" == p.url {
+			return nil, fmt.Errorf("'%s.url' is missing.", name)
+		}
+	case PROBE_TCP:
+		p.address = stringWithDefault(args[0], "address", "")
+		if "" == p.address {
+			return nil, fmt.Errorf("'%s.address' is missing.", name)
+		}
+	case PROBE_EXEC:
+		cmd, e := loadCommand(args)
+		if nil != e {
+			return nil, fmt.Errorf("'%s' is invalid, %v", name, e)
+		}
+		p.cmd = cmd
+	case PROBE_FILE:
+		p.path = stringWithDefault(args[0], "path", "")
+		if "" == p.path {
+			return nil, fmt.Errorf("'%s.path' is missing.", name)
+		}
+		p.path = os.Expand(p.path, envRead)
+	case "":
+		return nil, fmt.Errorf("'%s.type' is missing.", name)
+	default:
+		return nil, fmt.Errorf("'%s.type' is unsupported - %s", name, p.typ)
+	}
+	return p, nil
+}
@@ -0,0 +1,80 @@
+package daemontools
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+type readyCheck struct {
+	probe    *probe
+	interval time.Duration
+	timeout  time.Duration
+}
+
+// whenAll 返回一个函数， 它被调用 n 次后才会调用 cb
+func whenAll(n int, cb func()) func() {
+	if n <= 0 {
+		n = 1
+	}
+	var count int32
+	return func() {
+		if int32(n) == atomic.AddInt32(&count, 1) {
+			cb()
+		}
+	}
+}
+
+// readyLoop 周期性的检查进程是否已就绪， 就绪后调用 cb， 超时后终止进程,
+// 进程退出时 done 会被关闭。
+func (self *supervisor_default) readyLoop(done <-chan struct{}, cb func()) {
+	rc := self.ready
+
+	var deadline <-chan time.Time
+	if rc.timeout > 0 {
+		timer := time.NewTimer(rc.timeout)
+		defer timer.Stop()
+		deadline = timer.C
+	}
+
+	ticker := time.NewTicker(rc.interval)
+	defer ticker.Stop()
+
+	for {
+		e := rc.probe.check(self.mode)
+		if nil == e {
+			self.logString(fmt.Sprintf("[sys] ready check(%v) is ok.\r\n", rc.probe))
+			cb()
+			return
+		}
+
+		select {
+		case <-done:
+			return
+		case <-deadline:
+			self.logString(fmt.Sprintf("[sys] ready check(%v) is timed out after %v - %v\r\n", rc.probe, rc.timeout, e))
+			self.interruptBy(EXIT_REASON_NOT_READY)
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func loadReadyCheck(args []map[string]interface{}) (*readyCheck, error) {
+	p, e := loadProbe("ready", args, "check_timeout", 3*time.Second)
+	if nil != e {
+		return nil, e
+	}
+
+	rc := &readyCheck{probe: p,
+		interval: durationWithDefault(args[0], "interval", 1*time.Second),
+		timeout:  durationWithDefault(args[0], "timeout", 1*time.Minute)}
+	if rc.interval <= 0 {
+		return nil, errors.New("'ready.interval' must is greate 0s.")
+	}
+	if rc.timeout < 0 {
+		return nil, errors.New("'ready.timeout' must is greate or equal 0s.")
+	}
+	return rc, nil
+}
@@ -0,0 +1,75 @@
+package daemontools
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestReadyWithFile(t *testing.T) {
+	readyFile := filepath.Join(t.TempDir(), "ready")
+
+	var buffer lockedBuffer
+	wd, _ := os.Getwd()
+	s := &supervisor_default{ready: &readyCheck{probe: &probe{typ: PROBE_FILE, path: readyFile},
+		interval: 50 * time.Millisecond,
+		timeout:  10 * time.Second},
+		supervisorBase: supervisorBase{proc_name: "test_ready_file",
+			retries:     5,
+			killTimeout: time.Second,
+			out:         &buffer,
+			start_cmd: &command{proc: "go",
+				arguments: []string{"run", filepath.Join(wd, "mock", "run_forever.go")}}}}
+
+	s.start()
+
+	defer func() {
+		s.stop()
+		s.untilStopped()
+	}()
+
+	createdAt := time.Now()
+	time.AfterFunc(300*time.Millisecond, func() {
+		ioutil.WriteFile(readyFile, []byte("ok"), 0666)
+	})
+
+	if e := s.untilStarted(); nil != e {
+		t.Error(e)
+		t.Error(buffer.String())
+	} else if time.Now().Sub(createdAt) < 300*time.Millisecond {
+		t.Error("started before the ready file is created")
+	}
+}
+
+func TestReadyTimeout(t *testing.T) {
+	var buffer lockedBuffer
+	wd, _ := os.Getwd()
+	s := &supervisor_default{ready: &readyCheck{probe: &probe{typ: PROBE_FILE, path: filepath.Join(t.TempDir(), "ready")},
+		interval: 50 * time.Millisecond,
+		timeout:  200 * time.Millisecond},
+		supervisorBase: supervisorBase{proc_name: "test_ready_timeout",
+			retries:     2,
+			killTimeout: time.Second,
+			out:         &buffer,
+			start_cmd: &command{proc: "go",
+				arguments: []string{"run", filepath.Join(wd, "mock", "run_forever.go")}}}}
+
+	s.start()
+
+	defer func() {
+		s.stop()
+		s.untilStopped()
+	}()
+
+	if e := s.untilStarted(); nil == e {
+		t.Error(buffer.String())
+		return
+	}
+
+	runs := s.stats()["runs"].([]runRecord)
+	if 2 != len(runs) || EXIT_REASON_NOT_READY != runs[0].Reason {
+		t.Errorf("runs is invalid - %#v", runs)
+	}
+}
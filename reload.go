@@ -0,0 +1,164 @@
+package daemontools
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// configFingerprint 计算服务配置的摘要， 重新加载配置时摘要不同的服务会被重启。
+// 摘要包括服务自己的配置(模板中的全局参数已经展开了)、 从全局参数中继承后的
+// settings 和展开了全局参数及环境变量后实际执行的命令， 修改其它的全局参数不会
+// 重启服务。
+func configFingerprint(file string, attributes, settings map[string]interface{}, commands ...*command) string {
+	values := []interface{}{file, attributes, settings}
+	for _, cmd := range commands {
+		values = append(values, cmd.fingerprint())
+	}
+	bs, e := json.Marshal(values)
+	if nil != e {
+		bs = []byte(fmt.Sprint(values))
+	}
+	h := sha1.Sum(bs)
+	return hex.EncodeToString(h[:])
+}
+
+// reloadReport 是一次重新加载配置的结果
+type reloadReport struct {
+	Added     []string `json:"added"`
+	Removed   []string `json:"removed"`
+	Changed   []string `json:"changed"`
+	Unchanged []string `json:"unchanged"`
+	Errors    []string `json:"errors,omitempty"`
+}
+
+// reloadConfigs 重新加载所有的配置文件并按名称和现有的服务比较， 新增的服务会被
+// 启动， 删除的服务会被停止， 配置改变的服务会被重启， 没有改变的服务保持不变。
+func (self *Manager) reloadConfigs() (*reloadReport, error) {
+	if nil == self.load {
+		return nil, errors.New("reload is unsupported.")
+	}
+
+	self.reloadLock.Lock()
+	defer self.reloadLock.Unlock()
+
+	log.Println("[system] reload configs")
+	arguments, supervisors, e := self.load()
+	if nil != e {
+		return nil, e
+	}
+
+	current := self.list()
+	byName := map[string]supervisor{}
+	for _, sp := range current {
+		byName[sp.name()] = sp
+	}
+
+	report := &reloadReport{Added: []string{},
+		Removed:   []string{},
+		Changed:   []string{},
+		Unchanged: []string{}}
+	var added, stopList, startList []supervisor
+	var changed [][2]supervisor
+	results := make([]supervisor, 0, len(supervisors))
+	for _, sp := range supervisors {
+		old, ok := byName[sp.name()]
+		delete(byName, sp.name())
+
+		switch {
+		case !ok:
+			report.Added = append(report.Added, sp.name())
+			added = append(added, sp)
+			startList = append(startList, sp)
+		case old.fingerprint() != sp.fingerprint():
+			report.Changed = append(report.Changed, sp.name())
+			changed = append(changed, [2]supervisor{old, sp})
+			stopList = append(stopList, old)
+			startList = append(startList, sp)
+		default:
+			report.Unchanged = append(report.Unchanged, sp.name())
+			sp = old
+		}
+		results = append(results, sp)
+	}
+
+	var removed []supervisor
+	for _, sp := range current {
+		if _, ok := byName[sp.name()]; ok {
+			report.Removed = append(report.Removed, sp.name())
+			removed = append(removed, sp)
+			stopList = append(stopList, sp)
+		}
+	}
+
+	if e := openLogs(arguments, added); nil != e {
+		return nil, e
+	}
+	for _, sp := range startList {
+		sp.setManager(self)
+	}
+
+	report.Errors = runByDependencies(stopList, true, func(s supervisor) error {
+		s.stop()
+		if err := s.untilStopped(); nil != err {
+			return fmt.Errorf("stop '%v' failed, %v", s.name(), err)
+		}
+		return nil
+	})
+	// 等原来的服务停止后， 新的服务再继续使用它的日志文件
+	for _, pair := range changed {
+		pair[1].setOutput(pair[0].logOutput())
+	}
+	for _, sp := range removed {
+		if closer, ok := sp.logOutput().(io.Closer); ok {
+			closer.Close()
+		}
+	}
+
+	self.lock.Lock()
+	self.settings = arguments
+	self.supervisors = results
+	self.lock.Unlock()
+
+	var list []supervisor
+	for _, sp := range startList {
+		if self.IsSipped(sp.name()) || !sp.isMode(self.mode) {
+			continue
+		}
+		list = append(list, sp)
+	}
+	report.Errors = append(report.Errors, runByDependencies(list, false, func(s supervisor) error {
+		s.start()
+		if e := s.untilStarted(); nil != e {
+			return fmt.Errorf("start '%v' failed, %v", s.name(), e)
+		}
+		return nil
+	})...)
+
+	log.Printf("[system] reload configs is completed, added %v, removed %v, changed %v, unchanged %v\r\n",
+		report.Added, report.Removed, report.Changed, report.Unchanged)
+	for _, s := range report.Errors {
+		log.Println("[system]", s)
+	}
+	return report, nil
+}
+
+// reloadOnSignal 在收到 SIGHUP 时重新加载配置
+func (self *Manager) reloadOnSignal() {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, syscall.SIGHUP)
+	go func() {
+		for range c {
+			if _, e := self.reloadConfigs(); nil != e {
+				log.Println("[system] reload configs failed,", e)
+			}
+		}
+	}()
+}
@@ -0,0 +1,185 @@
+//go:build !windows
+// +build !windows
+
+package daemontools
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync/atomic"
+	"testing"
+)
+
+func writeAutostart(t *testing.T, root, name, script string) {
+	if e := ioutil.WriteFile(filepath.Join(root, "autostart_"+name+".conf"), []byte(`{
+  "name": "`+name+`",
+  "success_flag": "ok",
+  "start": {
+    "execute": "sh",
+    "arguments": ["-c", "`+script+`"]
+  }
+}`), 0666); nil != e {
+		t.Fatal(e)
+	}
+}
+
+func TestReloadConfigs(t *testing.T) {
+	oldLogDir := LogDir
+	LogDir = t.TempDir()
+	defer func() {
+		LogDir = oldLogDir
+	}()
+
+	root := t.TempDir()
+	const script = "echo ok; while true; do sleep 0.1; done"
+	writeAutostart(t, root, "a", script)
+	writeAutostart(t, root, "b", script)
+	writeAutostart(t, root, "c", script)
+
+	mgr, e := loadConfigs(root, nil, "", nil, nil)
+	if nil != e {
+		t.Fatal(e)
+	}
+	defer mgr.cr.Stop()
+	defer func() {
+		mgr.stopAll(true)
+	}()
+	if e := mgr.Restore(); nil != e {
+		t.Fatal(e)
+	}
+
+	pids := map[string]int64{}
+	for _, sp := range mgr.supervisors {
+		pids[sp.name()] = sp.GetStatus().Pid
+	}
+
+	writeAutostart(t, root, "b", "echo changed; "+script)
+	writeAutostart(t, root, "d", script)
+	if e := os.Remove(filepath.Join(root, "autostart_c.conf")); nil != e {
+		t.Fatal(e)
+	}
+
+	rec := httptest.NewRecorder()
+	mgr.ServeHTTP(rec, httptest.NewRequest("POST", "/reload", nil))
+	if http.StatusOK != rec.Code {
+		t.Fatal(rec.Code, rec.Body.String())
+	}
+	var report reloadReport
+	if e := json.Unmarshal(rec.Body.Bytes(), &report); nil != e {
+		t.Fatal(e)
+	}
+	if !reflect.DeepEqual(report, reloadReport{Added: []string{"d"},
+		Removed:   []string{"c"},
+		Changed:   []string{"b"},
+		Unchanged: []string{"a"}}) {
+		t.Errorf("%#v", report)
+	}
+
+	names := mgr.Names()
+	if !reflect.DeepEqual(names, []string{"a", "b", "d"}) {
+		t.Error(names)
+	}
+	for _, sp := range mgr.supervisors {
+		pid := sp.GetStatus().Pid
+		if 0 == pid {
+			t.Error(sp.name(), "is not running")
+		}
+		switch sp.name() {
+		case "a":
+			if pids["a"] != pid {
+				t.Error("a is restarted")
+			}
+		case "b":
+			if pids["b"] == pid {
+				t.Error("b is not restarted")
+			}
+		}
+	}
+}
+
+func TestReloadConfigsWithGlobals(t *testing.T) {
+	oldLogDir := LogDir
+	LogDir = t.TempDir()
+	defer func() {
+		LogDir = oldLogDir
+	}()
+
+	var tag atomic.Value
+	tag.Store("1")
+	SetCfgRead(func(key, defaultValue string) string {
+		if "tag" == key {
+			return tag.Load().(string)
+		}
+		return defaultValue
+	})
+	defer SetCfgRead(nil)
+
+	root := t.TempDir()
+	properties := filepath.Join(root, "daemon.properties")
+	if e := ioutil.WriteFile(properties, []byte("unrelated=1\nmsg=hello\n"), 0666); nil != e {
+		t.Fatal(e)
+	}
+	writeAutostart(t, root, "a", "echo {{.msg}}; echo ok; while true; do sleep 0.1; done")
+	writeAutostart(t, root, "b", "echo ${tag} ok; while true; do sleep 0.1; done")
+
+	mgr, e := loadConfigs(root, nil, "", []string{properties}, nil)
+	if nil != e {
+		t.Fatal(e)
+	}
+	defer mgr.cr.Stop()
+	defer func() {
+		mgr.stopAll(true)
+	}()
+	if e := mgr.Restore(); nil != e {
+		t.Fatal(e)
+	}
+
+	if e := ioutil.WriteFile(properties, []byte("unrelated=2\nmsg=hello\n"), 0666); nil != e {
+		t.Fatal(e)
+	}
+	report, e := mgr.reloadConfigs()
+	if nil != e {
+		t.Fatal(e)
+	}
+	if !reflect.DeepEqual(report.Unchanged, []string{"a", "b"}) || 0 != len(report.Changed) {
+		t.Errorf("%#v", report)
+	}
+
+	if e := ioutil.WriteFile(properties, []byte("unrelated=2\nmsg=world\n"), 0666); nil != e {
+		t.Fatal(e)
+	}
+	report, e = mgr.reloadConfigs()
+	if nil != e {
+		t.Fatal(e)
+	}
+	if !reflect.DeepEqual(report.Changed, []string{"a"}) || !reflect.DeepEqual(report.Unchanged, []string{"b"}) {
+		t.Errorf("%#v", report)
+	}
+
+	// 服务没有配置 retries 时使用全局的值
+	if e := ioutil.WriteFile(properties, []byte("unrelated=2\nmsg=world\nretries=3\n"), 0666); nil != e {
+		t.Fatal(e)
+	}
+	report, e = mgr.reloadConfigs()
+	if nil != e {
+		t.Fatal(e)
+	}
+	if !reflect.DeepEqual(report.Changed, []string{"a", "b"}) || 0 != len(report.Unchanged) {
+		t.Errorf("%#v", report)
+	}
+
+	// 命令中的环境变量是展开后再计算摘要的
+	tag.Store("2")
+	report, e = mgr.reloadConfigs()
+	if nil != e {
+		t.Fatal(e)
+	}
+	if !reflect.DeepEqual(report.Changed, []string{"b"}) || !reflect.DeepEqual(report.Unchanged, []string{"a"}) {
+		t.Errorf("%#v", report)
+	}
+}
@@ -0,0 +1,163 @@
+package daemontools
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const rlimitInfinity = ^uint64(0)
+
+var rlimitNames = []string{"nofile", "nproc", "core", "as", "stack", "cpu"}
+
+type rlimit struct {
+	name string
+	soft uint64
+	hard uint64
+}
+
+func rlimitValueString(value uint64) string {
+	if rlimitInfinity == value {
+		return "unlimited"
+	}
+	return strconv.FormatUint(value, 10)
+}
+
+func (self *rlimit) String() string {
+	return self.name + "=" + rlimitValueString(self.soft) + ":" + rlimitValueString(self.hard)
+}
+
+func parseRlimitValue(name, s string) (uint64, error) {
+	s = strings.TrimSpace(s)
+	switch strings.ToLower(s) {
+	case "unlimited", "infinity", "-1":
+		return rlimitInfinity, nil
+	case "":
+		return 0, errors.New("value of '" + name + "' is empty")
+	}
+
+	pos := strings.IndexFunc(s, func(c rune) bool {
+		return !unicode.IsDigit(c)
+	})
+	if pos < 0 {
+		pos = len(s)
+	}
+	if 0 == pos {
+		return 0, errors.New("value '" + s + "' of '" + name + "' is invalid")
+	}
+	value, e := strconv.ParseUint(s[:pos], 10, 64)
+	if nil != e {
+		return 0, errors.New("value '" + s + "' of '" + name + "' is invalid, " + e.Error())
+	}
+
+	unit := strings.ToLower(s[pos:])
+	if "" == unit {
+		return value, nil
+	}
+	switch name {
+	case "core", "as", "stack":
+	default:
+		return 0, errors.New("value '" + s + "' of '" + name + "' is invalid, unit is unsupported")
+	}
+	switch unit {
+	case "kb", "k", "kib":
+		return value * 1024, nil
+	case "mb", "m", "mib":
+		return value * 1024 * 1024, nil
+	case "g", "gb", "gib":
+		return value * 1024 * 1024 * 1024, nil
+	}
+	return 0, errors.New("value '" + s + "' of '" + name + "' is invalid, unit is unsupported")
+}
+
+// parseRlimit 解析 "soft:hard" 或 "value" 格式的资源限制, 只指定一个值时
+// soft 和 hard 相同。
+func parseRlimit(name, s string) (rlimit, error) {
+	limit := rlimit{name: name}
+	ss := strings.SplitN(s, ":", 2)
+	var e error
+	limit.soft, e = parseRlimitValue(name, ss[0])
+	if nil != e {
+		return limit, e
+	}
+	limit.hard = limit.soft
+	if 2 == len(ss) {
+		limit.hard, e = parseRlimitValue(name, ss[1])
+		if nil != e {
+			return limit, e
+		}
+		if limit.soft > limit.hard {
+			return limit, errors.New("soft limit of '" + name + "' is greate than hard limit")
+		}
+	}
+	return limit, nil
+}
+
+func loadRlimits(values map[string]interface{}) ([]rlimit, error) {
+	var limits []rlimit
+	for key, value := range values {
+		name := strings.ToLower(strings.TrimSpace(key))
+		found := false
+		for _, nm := range rlimitNames {
+			if nm == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, errors.New("limit '" + key + "' is unsupported, it must is one of " + strings.Join(rlimitNames, ", "))
+		}
+
+		var s string
+		switch v := value.(type) {
+		case float64:
+			if v < 0 {
+				s = "unlimited"
+			} else {
+				s = strconv.FormatFloat(v, 'f', 0, 64)
+			}
+		default:
+			s = fmt.Sprint(v)
+		}
+
+		limit, e := parseRlimit(name, s)
+		if nil != e {
+			return nil, errors.New("'limits' is invalid, " + e.Error())
+		}
+		limits = append(limits, limit)
+	}
+	sort.Slice(limits, func(i, j int) bool {
+		return limits[i].name < limits[j].name
+	})
+	return limits, nil
+}
+
+func encodeRlimits(limits []rlimit) string {
+	ss := make([]string, len(limits))
+	for idx := range limits {
+		ss[idx] = limits[idx].name + "=" + strconv.FormatUint(limits[idx].soft, 10) + ":" + strconv.FormatUint(limits[idx].hard, 10)
+	}
+	return strings.Join(ss, ";")
+}
+
+func decodeRlimits(s string) ([]rlimit, error) {
+	var limits []rlimit
+	for _, item := range strings.Split(s, ";") {
+		if "" == item {
+			continue
+		}
+		kv := strings.SplitN(item, "=", 2)
+		if 2 != len(kv) {
+			return nil, errors.New("limit '" + item + "' is invalid")
+		}
+		limit, e := parseRlimit(kv[0], kv[1])
+		if nil != e {
+			return nil, e
+		}
+		limits = append(limits, limit)
+	}
+	return limits, nil
+}
@@ -0,0 +1,109 @@
+package daemontools
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+var rlimitResources = map[string]int{
+	"nofile": syscall.RLIMIT_NOFILE,
+	"nproc":  rlimitNproc,
+	"core":   syscall.RLIMIT_CORE,
+	"as":     syscall.RLIMIT_AS,
+	"stack":  syscall.RLIMIT_STACK,
+	"cpu":    syscall.RLIMIT_CPU,
+}
+
+func setRlimits(spec string) error {
+	limits, e := decodeRlimits(spec)
+	if nil != e {
+		return e
+	}
+	for _, limit := range limits {
+		resource, ok := rlimitResources[limit.name]
+		if !ok {
+			return errors.New("limit '" + limit.name + "' is unsupported")
+		}
+		if e := syscall.Setrlimit(resource, &syscall.Rlimit{Cur: limit.soft, Max: limit.hard}); nil != e {
+			return errors.New("set '" + limit.name + "' to " + limit.String() + " failed, " + e.Error())
+		}
+	}
+	return nil
+}
+
+func applyRlimits(cmd *exec.Cmd, limits []rlimit) {
+	if 0 == len(limits) || "" == cmd.Path {
+		return
+	}
+	preexec(cmd, rlimitsEnv, encodeRlimits(limits))
+}
+
+var procLimitTitles = map[string]string{
+	"Max open files":     "nofile",
+	"Max processes":      "nproc",
+	"Max core file size": "core",
+	"Max address space":  "as",
+	"Max stack size":     "stack",
+	"Max cpu time":       "cpu",
+}
+
+// readProcLimits 读取 /proc/<pid>/limits， 返回 名称 -> [soft, hard]
+func readProcLimits(pid int) map[string][2]string {
+	f, e := os.Open("/proc/" + strconv.Itoa(pid) + "/limits")
+	if nil != e {
+		return nil
+	}
+	defer f.Close()
+
+	results := map[string][2]string{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		for title, name := range procLimitTitles {
+			if !strings.HasPrefix(line, title) {
+				continue
+			}
+			fields := strings.Fields(strings.TrimPrefix(line, title))
+			if len(fields) >= 2 {
+				results[name] = [2]string{fields[0], fields[1]}
+			}
+		}
+	}
+	return results
+}
+
+// effectiveRlimits 读取 /proc/<pid>/limits 中的实际值
+func effectiveRlimits(pid int, limits []rlimit) map[string]string {
+	results := map[string]string{}
+	for idx := range limits {
+		results[limits[idx].name] = rlimitValueString(limits[idx].soft) + ":" + rlimitValueString(limits[idx].hard)
+	}
+	if 0 == pid || 0 == len(limits) {
+		return results
+	}
+
+	for name, values := range readProcLimits(pid) {
+		if _, ok := results[name]; ok {
+			results[name] = values[0] + ":" + values[1]
+		}
+	}
+	return results
+}
+
+// softRlimit 返回进程的 soft 限制， 没有限制时返回 false
+func softRlimit(pid int, name string) (uint64, bool) {
+	values, ok := readProcLimits(pid)[name]
+	if !ok {
+		return 0, false
+	}
+	value, e := strconv.ParseUint(values[0], 10, 64)
+	if nil != e {
+		return 0, false
+	}
+	return value, true
+}
@@ -0,0 +1,7 @@
+//go:build linux && !mips && !mipsle && !mips64 && !mips64le
+// +build linux,!mips,!mipsle,!mips64,!mips64le
+
+package daemontools
+
+// syscall 中没有 RLIMIT_NPROC， 它的值与体系结构有关
+const rlimitNproc = 0x6
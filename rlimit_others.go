@@ -0,0 +1,23 @@
+//go:build !linux
+// +build !linux
+
+package daemontools
+
+import (
+	"os/exec"
+)
+
+func applyRlimits(cmd *exec.Cmd, limits []rlimit) {
+}
+
+func effectiveRlimits(pid int, limits []rlimit) map[string]string {
+	results := map[string]string{}
+	for idx := range limits {
+		results[limits[idx].name] = rlimitValueString(limits[idx].soft) + ":" + rlimitValueString(limits[idx].hard)
+	}
+	return results
+}
+
+func softRlimit(pid int, name string) (uint64, bool) {
+	return 0, false
+}
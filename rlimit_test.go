@@ -0,0 +1,78 @@
+package daemontools
+
+import (
+	"runtime"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLoadRlimits(t *testing.T) {
+	limits, e := loadRlimits(map[string]interface{}{"nofile": float64(65535),
+		"core":  "unlimited",
+		"stack": "8m:16m",
+		"nproc": "1024:2048"})
+	if nil != e {
+		t.Fatal(e)
+	}
+
+	var ss []string
+	for idx := range limits {
+		ss = append(ss, limits[idx].String())
+	}
+	excepted := "core=unlimited:unlimited,nofile=65535:65535,nproc=1024:2048,stack=8388608:16777216"
+	if s := strings.Join(ss, ","); excepted != s {
+		t.Error("excepted is", excepted, ", actual is", s)
+	}
+
+	decoded, e := decodeRlimits(encodeRlimits(limits))
+	if nil != e {
+		t.Error(e)
+	} else if len(decoded) != len(limits) || decoded[0] != limits[0] || decoded[2] != limits[2] {
+		t.Errorf("decode is invalid - %v", decoded)
+	}
+
+	for _, values := range []map[string]interface{}{{"abc": 1},
+		{"nofile": "abc"},
+		{"nofile": "10k"},
+		{"nofile": "20:10"}} {
+		if _, e := loadRlimits(values); nil == e {
+			t.Error(values, "excepted is error, actual is ok")
+		}
+	}
+}
+
+func TestStartWithRlimits(t *testing.T) {
+	if "linux" != runtime.GOOS {
+		t.Skip("limits is supported on linux only")
+	}
+
+	var buffer lockedBuffer
+	s := &supervisor_default{restartPolicy: RESTART_NEVER,
+		supervisorBase: supervisorBase{proc_name: "test_limits",
+			retries:     1,
+			killTimeout: time.Second,
+			out:         &buffer,
+			start_cmd: &command{proc: "sh",
+				arguments: []string{"-c", "echo nofile=$(ulimit -n)"},
+				limits:    []rlimit{{name: "nofile", soft: 321, hard: 321}}}}}
+
+	s.start()
+
+	defer func() {
+		s.stop()
+		s.untilStopped()
+	}()
+
+	for i := 0; i < 100; i++ {
+		if SRV_EXITED == atomic.LoadInt32(&s.srv_status) {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	if ss := buffer.String(); !strings.Contains(ss, "nofile=321") {
+		t.Error(ss)
+	}
+}
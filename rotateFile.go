@@ -15,6 +15,10 @@ type rotateFile struct {
 	currentBytes int
 	maxNum       int
 
+	hasOwner bool
+	uid      int
+	gid      int
+
 	file *os.File
 }
 
@@ -66,6 +70,14 @@ func (w *rotateFile) Close() error {
 	return w.file.Close()
 }
 
+// chown 修改日志文件的所有者， 以后滚动产生的新文件也会使用这个所有者
+func (w *rotateFile) chown(uid, gid int) error {
+	w.hasOwner = true
+	w.uid = uid
+	w.gid = gid
+	return w.file.Chown(uid, gid)
+}
+
 func NewRotateFile(fname string, maxBytes, maxNum int) (*rotateFile, error) {
 	w := &rotateFile{filename: fname, maxBytes: maxBytes, currentBytes: 0, maxNum: maxNum}
 
@@ -133,5 +145,10 @@ func (w *rotateFile) initRotate(isError bool) error {
 	}
 	w.file = fd
 	w.currentBytes = 0
+	if w.hasOwner {
+		if err := fd.Chown(w.uid, w.gid); nil != err {
+			return err
+		}
+	}
 	return nil
 }
@@ -0,0 +1,129 @@
+package daemontools
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"time"
+)
+
+const (
+	EXIT_REASON_EXITED        = "exited"
+	EXIT_REASON_KILLED        = "killed"
+	EXIT_REASON_UNHEALTHY     = "unhealthy"
+	EXIT_REASON_NOT_READY     = "not_ready"
+	EXIT_REASON_FAILURE_FLAG  = "failure_flag"
+	EXIT_REASON_START_TIMEOUT = "start_timeout"
+	EXIT_REASON_WATCHDOG      = "watchdog"
+	EXIT_REASON_OOM           = "oom"
+	EXIT_REASON_THRESHOLD     = "threshold"
+	EXIT_REASON_TIMEOUT       = "timeout"
+	EXIT_REASON_OVERLAP       = "overlap"
+	EXIT_REASON_CRASHED       = "crashed"
+	EXIT_REASON_LOST          = "lost"
+	EXIT_REASON_START_FAILED  = "start_failed"
+	EXIT_REASON_SCHEDULE      = "schedule"
+)
+
+// isSelfInterrupted 判断进程是否是被 daemontools 自己终止的(如健康检查失败)，
+// 这时总是重启它， 不受重启策略的限制
+func isSelfInterrupted(reason string) bool {
+	switch reason {
+	case EXIT_REASON_UNHEALTHY,
+		EXIT_REASON_WATCHDOG,
+		EXIT_REASON_THRESHOLD,
+		EXIT_REASON_NOT_READY,
+		EXIT_REASON_START_TIMEOUT,
+		EXIT_REASON_SCHEDULE:
+		return true
+	}
+	return false
+}
+
+type runRecord struct {
+	StartAt  time.Time `json:"start_at"`
+	EndAt    time.Time `json:"end_at"`
+	Pid      int       `json:"pid"`
+	ExitCode int       `json:"exit_code"`
+	Signal   string    `json:"signal,omitempty"`
+	CoreDump bool      `json:"core_dump,omitempty"`
+	Reason   string    `json:"reason"`
+	Message  string    `json:"message,omitempty"`
+}
+
+func (self *runRecord) String() string {
+	var txt string
+	switch self.Reason {
+	case EXIT_REASON_START_FAILED:
+		txt = "start failed"
+	case EXIT_REASON_LOST:
+		txt = fmt.Sprintf("pid(%d) is lost from the process list", self.Pid)
+	default:
+		txt = fmt.Sprintf("%s, pid(%d) exit code is %d", self.Reason, self.Pid, self.ExitCode)
+		if "" != self.Signal {
+			txt += ", signal is " + self.Signal
+		}
+		if self.CoreDump {
+			txt += ", core dumped"
+		}
+	}
+	if "" != self.Message {
+		txt += " - " + self.Message
+	}
+	return txt
+}
+
+// setExit 根据 cmd.Wait() 的结果填充退出码、信号及退出原因。
+func (self *runRecord) setExit(state *os.ProcessState, e error, killedReason string) {
+	self.ExitCode = -1
+	if nil == state {
+		if exitErr, ok := e.(*exec.ExitError); ok {
+			state = exitErr.ProcessState
+		}
+	}
+	if nil != state {
+		self.ExitCode = state.ExitCode()
+		self.Signal, self.CoreDump = signalOf(state)
+	}
+	if nil != e {
+		self.Message = e.Error()
+	}
+
+	switch {
+	case "" != killedReason:
+		self.Reason = killedReason
+	case 0 == self.ExitCode && "" == self.Signal:
+		self.Reason = EXIT_REASON_EXITED
+	default:
+		self.Reason = EXIT_REASON_CRASHED
+	}
+}
+
+type runHistory struct {
+	size    int
+	records []runRecord
+}
+
+func (self *runHistory) add(record runRecord) {
+	size := self.size
+	if size <= 0 {
+		size = 10
+	}
+	if len(self.records) >= size {
+		copy(self.records, self.records[len(self.records)-size+1:])
+		self.records = self.records[:size-1]
+	}
+	self.records = append(self.records, record)
+}
+
+func (self *runHistory) last() *runRecord {
+	if 0 == len(self.records) {
+		return nil
+	}
+	record := self.records[len(self.records)-1]
+	return &record
+}
+
+func (self *runHistory) list() []runRecord {
+	return append([]runRecord{}, self.records...)
+}
@@ -0,0 +1,105 @@
+package daemontools
+
+import (
+	"bufio"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// propertyKey 按 readProperties 的规则返回一行中的 key， 不是属性时返回 ""
+func propertyKey(line string) string {
+	s := strings.TrimSpace(strings.SplitN(line, "#", 2)[0])
+	ss := strings.SplitN(s, "=", 2)
+	if 2 != len(ss) {
+		return ""
+	}
+	return strings.TrimLeft(strings.TrimSpace(ss[0]), ".")
+}
+
+// writeProperties 将 values 写入属性文件， 文件中已有的 key 会替换原来的行， 没有的
+// 追加在文件最后， 其它的内容保持不变。 写入时先写临时文件再改名， 保证文件总是完整的。
+func writeProperties(file string, values map[string]string) error {
+	// 文件是符号链接时修改它指向的文件
+	if real, e := filepath.EvalSymlinks(file); nil == e {
+		file = real
+	}
+
+	var lines []string
+	mode := os.FileMode(0644)
+	bs, e := ioutil.ReadFile(file)
+	if nil != e {
+		if !os.IsNotExist(e) {
+			return e
+		}
+	} else {
+		if fi, e := os.Stat(file); nil == e {
+			mode = fi.Mode().Perm()
+		}
+		scanner := bufio.NewScanner(bytes.NewReader(bs))
+		for scanner.Scan() {
+			lines = append(lines, scanner.Text())
+		}
+		if e := scanner.Err(); nil != e {
+			return e
+		}
+	}
+
+	written := map[string]bool{}
+	results := make([]string, 0, len(lines)+len(values))
+	for _, line := range lines {
+		key := propertyKey(line)
+		value, ok := values[key]
+		if "" == key || !ok {
+			results = append(results, line)
+			continue
+		}
+		// 同一个 key 有多行时只保留第一行
+		if written[key] {
+			continue
+		}
+		written[key] = true
+		results = append(results, key+"="+value)
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		if !written[key] {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		results = append(results, key+"="+values[key])
+	}
+
+	dir := filepath.Dir(file)
+	if e := os.MkdirAll(dir, 0755); nil != e {
+		return e
+	}
+	tmp, e := ioutil.TempFile(dir, filepath.Base(file)+".tmp")
+	if nil != e {
+		return e
+	}
+	tmpName := tmp.Name()
+	defer os.Remove(tmpName)
+
+	if _, e := tmp.WriteString(strings.Join(results, "\n") + "\n"); nil != e {
+		tmp.Close()
+		return e
+	}
+	if e := tmp.Sync(); nil != e {
+		tmp.Close()
+		return e
+	}
+	if e := tmp.Close(); nil != e {
+		return e
+	}
+	if e := os.Chmod(tmpName, mode); nil != e {
+		return e
+	}
+	return os.Rename(tmpName, file)
+}
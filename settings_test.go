@@ -0,0 +1,81 @@
+package daemontools
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteProperties(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "conf", "daemon.properties")
+	if e := writeProperties(file, map[string]string{"a.disabled": "true"}); nil != e {
+		t.Fatal(e)
+	}
+	if bs, _ := ioutil.ReadFile(file); "a.disabled=true\n" != string(bs) {
+		t.Errorf("%q", bs)
+	}
+
+	if e := ioutil.WriteFile(file, []byte("# comment\nlogPath=/var/log\n a.disabled = true # old\nb=1\na.disabled=false\n"), 0640); nil != e {
+		t.Fatal(e)
+	}
+	if e := os.Chmod(file, 0640); nil != e {
+		t.Fatal(e)
+	}
+	if e := writeProperties(file, map[string]string{"a.disabled": "false", "c.disabled": "true"}); nil != e {
+		t.Fatal(e)
+	}
+	bs, _ := ioutil.ReadFile(file)
+	if excepted := "# comment\nlogPath=/var/log\na.disabled=false\nb=1\nc.disabled=true\n"; excepted != string(bs) {
+		t.Errorf("%q", bs)
+	}
+	if fi, e := os.Stat(file); nil != e {
+		t.Error(e)
+	} else if os.FileMode(0640) != fi.Mode().Perm() {
+		t.Error("mode is changed -", fi.Mode())
+	}
+	matches, _ := filepath.Glob(filepath.Join(filepath.Dir(file), "*.tmp*"))
+	if 0 != len(matches) {
+		t.Error("temp file is not removed -", matches)
+	}
+}
+
+func TestSaveDisabledByAPI(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "daemon.properties")
+	if e := ioutil.WriteFile(file, []byte("logPath=/var/log\n"), 0666); nil != e {
+		t.Fatal(e)
+	}
+
+	worker0 := &supervisor_default{supervisorBase: supervisorBase{proc_name: "worker-0", group: "worker"}}
+	worker1 := &supervisor_default{supervisorBase: supervisorBase{proc_name: "worker-1", group: "worker", instance: 1}}
+	mgr := &Manager{settings_file: file, supervisors: []supervisor{worker0, worker1}}
+
+	rec := httptest.NewRecorder()
+	mgr.ServeHTTP(rec, httptest.NewRequest("POST", "/worker/stop", nil))
+	if http.StatusOK != rec.Code {
+		t.Fatal(rec.Code, rec.Body.String())
+	}
+	bs, _ := ioutil.ReadFile(file)
+	if excepted := "logPath=/var/log\nworker-0.disabled=true\nworker-1.disabled=true\nworker.disabled=true\n"; excepted != string(bs) {
+		t.Errorf("%q", bs)
+	}
+
+	rec = httptest.NewRecorder()
+	mgr.ServeHTTP(rec, httptest.NewRequest("POST", "/worker-1/start", nil))
+	defer func() {
+		worker1.stop()
+		worker1.untilStopped()
+	}()
+	if http.StatusOK != rec.Code {
+		t.Fatal(rec.Code, rec.Body.String())
+	}
+	bs, _ = ioutil.ReadFile(file)
+	if excepted := "logPath=/var/log\nworker-0.disabled=true\nworker-1.disabled=false\nworker.disabled=true\n"; excepted != string(bs) {
+		t.Errorf("%q", bs)
+	}
+	if !mgr.IsSipped("worker-0") || mgr.IsSipped("worker-1") {
+		t.Error(mgr.skipped)
+	}
+}
@@ -0,0 +1,77 @@
+package daemontools
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// signalStep 是停止序列中的一步， 发送信号 sig 后最多等待 wait
+type signalStep struct {
+	name string
+	sig  os.Signal
+	wait time.Duration
+}
+
+func (self signalStep) String() string {
+	return self.name + " " + self.wait.String()
+}
+
+// parseSignal 支持 "term"、"SIGTERM"、"TERM" 和 "15" 这几种格式
+func parseSignal(s string) (string, os.Signal, error) {
+	name := strings.ToUpper(strings.TrimSpace(s))
+	name = strings.TrimPrefix(name, "SIG")
+	if sig, ok := signalNames[name]; ok {
+		return "SIG" + name, sig, nil
+	}
+	if n, e := strconv.Atoi(name); nil == e {
+		for nm, sig := range signalNames {
+			if signalNumber(sig) == n {
+				return "SIG" + nm, sig, nil
+			}
+		}
+	}
+	return "", nil, errors.New("signal '" + s + "' is unsupported")
+}
+
+// parseSignalSequence 解析如 ["term", "10s", "kill"] 的停止序列， 信号后面
+// 的时间是发送该信号后等待进程退出的时间， 未指定时使用 defaultWait。
+func parseSignalSequence(ss []string, defaultWait time.Duration) ([]signalStep, error) {
+	var steps []signalStep
+	for _, s := range ss {
+		s = strings.TrimSpace(s)
+		if "" == s {
+			continue
+		}
+		if 0 != len(steps) && s[0] >= '0' && s[0] <= '9' {
+			if wait, e := time.ParseDuration(s); nil == e {
+				if wait <= 0 {
+					return nil, errors.New("wait time '" + s + "' must is greate 0s.")
+				}
+				steps[len(steps)-1].wait = wait
+				continue
+			}
+		}
+
+		name, sig, e := parseSignal(s)
+		if nil != e {
+			return nil, e
+		}
+		steps = append(steps, signalStep{name: name, sig: sig, wait: defaultWait})
+	}
+	if 0 == len(steps) {
+		return nil, errors.New("signal is empty")
+	}
+	return steps, nil
+}
+
+// defaultEscalation 是停止命令失败后默认的停止序列
+func defaultEscalation(wait time.Duration) []signalStep {
+	var steps []signalStep
+	if sig, ok := signalNames["TERM"]; ok {
+		steps = append(steps, signalStep{name: "SIGTERM", sig: sig, wait: wait})
+	}
+	return append(steps, signalStep{name: "SIGKILL", sig: os.Kill, wait: wait})
+}
@@ -0,0 +1,111 @@
+//go:build !windows
+// +build !windows
+
+package daemontools
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestReload(t *testing.T) {
+	for _, reload := range []*command{{proc: "__signal__", arguments: []string{"hup"}},
+		{proc: "__signal__"},
+		{proc: "sh", arguments: []string{"-c", "kill -HUP $DAEMON_PID"}}} {
+		var buffer lockedBuffer
+		s := &supervisor_default{success_flag: "ok",
+			supervisorBase: supervisorBase{proc_name: "test_reload",
+				retries:     1,
+				killTimeout: time.Second,
+				out:         &buffer,
+				reload_cmd:  reload,
+				start_cmd: &command{proc: "sh",
+					arguments: []string{"-c", "trap 'echo reloaded_$((1+1))' HUP; echo ok; while true; do sleep 0.1; done"}}}}
+		mgr := &Manager{supervisors: []supervisor{s}}
+
+		s.start()
+		if e := s.untilStarted(); nil != e {
+			t.Fatal(e)
+		}
+		pid := s.GetStatus().Pid
+
+		req := httptest.NewRequest("POST", "/test_reload/reload", nil)
+		rec := httptest.NewRecorder()
+		mgr.ServeHTTP(rec, req)
+		if http.StatusOK != rec.Code {
+			t.Error(reload.proc, rec.Code, rec.Body.String())
+		}
+
+		for i := 0; i < 20 && !strings.Contains(buffer.String(), "reloaded_2"); i++ {
+			time.Sleep(100 * time.Millisecond)
+		}
+		if !strings.Contains(buffer.String(), "reloaded_2") {
+			t.Error(reload.proc, buffer.String())
+		}
+		if SRV_RUNNING != atomic.LoadInt32(&s.srv_status) || pid != s.GetStatus().Pid {
+			t.Error(reload.proc, "status or pid is changed")
+		}
+		if runs := s.stats()["runs"].([]runRecord); 0 != len(runs) {
+			t.Errorf("runs is invalid - %#v", runs)
+		}
+
+		s.stop()
+		s.untilStopped()
+	}
+
+	s := &supervisor_default{supervisorBase: supervisorBase{proc_name: "test_reload"}}
+	if e := s.reload(); nil == e || !strings.Contains(e.Error(), "not support reload") {
+		t.Error(e)
+	}
+}
+
+func TestSignalByAPI(t *testing.T) {
+	var buffer lockedBuffer
+	s := &supervisor_default{success_flag: "ok",
+		supervisorBase: supervisorBase{proc_name: "test_signal",
+			retries:     1,
+			killTimeout: time.Second,
+			out:         &buffer,
+			start_cmd: &command{proc: "sh",
+				arguments: []string{"-c", "trap 'echo usr1_$((1+1))' USR1; echo ok; while true; do sleep 0.1; done"}}}}
+	pidfile := &supervisorWithPidfile{pidfile: "daemontools_not_exists.pid",
+		supervisorBase: supervisorBase{proc_name: "test_pidfile"}}
+	mgr := &Manager{supervisors: []supervisor{s, pidfile}}
+
+	s.start()
+	defer func() {
+		s.stop()
+		s.untilStopped()
+	}()
+	if e := s.untilStarted(); nil != e {
+		t.Fatal(e)
+	}
+	pid := strconv.FormatInt(s.GetStatus().Pid, 10)
+
+	for _, test := range []struct {
+		url  string
+		code int
+		body string
+	}{{url: "/test_signal/signal/usr1", code: http.StatusOK, body: "send SIGUSR1 to pid(" + pid + ")"},
+		{url: "/test_signal/signal/SIGUSR1?group=true", code: http.StatusOK, body: "send SIGUSR1 to process group(" + pid + ")"},
+		{url: "/test_signal/signal/abc", code: http.StatusInternalServerError, body: "unsupported"},
+		{url: "/test_pidfile/signal/usr1", code: http.StatusForbidden, body: "not owned"}} {
+		rec := httptest.NewRecorder()
+		mgr.ServeHTTP(rec, httptest.NewRequest("POST", test.url, nil))
+		if test.code != rec.Code || !strings.Contains(rec.Body.String(), test.body) {
+			t.Error(test.url, rec.Code, rec.Body.String())
+		}
+	}
+
+	for i := 0; i < 20 && !strings.Contains(buffer.String(), "usr1_2"); i++ {
+		time.Sleep(100 * time.Millisecond)
+	}
+	if !strings.Contains(buffer.String(), "usr1_2") {
+		t.Error(buffer.String())
+	}
+}
@@ -0,0 +1,33 @@
+package daemontools
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestParseSignalSequence(t *testing.T) {
+	steps, e := parseSignalSequence([]string{"int", "10s", "SIGKILL"}, 3*time.Second)
+	if nil != e {
+		t.Fatal(e)
+	}
+	if 2 != len(steps) ||
+		"SIGINT" != steps[0].name || os.Interrupt != steps[0].sig || 10*time.Second != steps[0].wait ||
+		"SIGKILL" != steps[1].name || os.Kill != steps[1].sig || 3*time.Second != steps[1].wait {
+		t.Errorf("%v", steps)
+	}
+
+	steps, e = parseSignalSequence([]string{"9"}, time.Second)
+	if nil != e {
+		t.Fatal(e)
+	}
+	if 1 != len(steps) || os.Kill != steps[0].sig {
+		t.Errorf("%v", steps)
+	}
+
+	for _, ss := range [][]string{nil, {"10s"}, {"abc"}, {"kill", "-1s"}} {
+		if _, e := parseSignalSequence(ss, time.Second); nil == e {
+			t.Error(ss, "excepted error")
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package daemontools
+
+import (
+	"os"
+	"time"
+)
+
+var signalNames = map[string]os.Signal{
+	"INT":  os.Interrupt,
+	"KILL": os.Kill,
+}
+
+func signalNumber(sig os.Signal) int {
+	switch sig {
+	case os.Interrupt:
+		return 2
+	case os.Kill:
+		return 9
+	}
+	return -1
+}
+
+func waitExit(pr *os.Process, timeout time.Duration) error {
+	return waitWithTimeout(timeout, pr)
+}
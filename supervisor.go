@@ -1,12 +1,14 @@
 package daemontools
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"log"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -19,6 +21,7 @@ const (
 	SRV_STARTING = 1
 	SRV_RUNNING  = 2
 	SRV_STOPPING = 3
+	SRV_EXITED   = 4
 
 	PROC_INIT     = 0
 	PROC_STARTING = 1
@@ -33,6 +36,8 @@ func statusString(srv_status, proc_status int32) string {
 		return "disabled"
 	case SRV_STOPPING:
 		return "disabling"
+	case SRV_EXITED:
+		return "exited"
 	case SRV_STARTING, SRV_RUNNING:
 		switch proc_status {
 		case PROC_INIT:
@@ -60,6 +65,8 @@ func srvString(status int32) string {
 		return "SRV_RUNNING"
 	case SRV_STOPPING:
 		return "SRV_STOPPING"
+	case SRV_EXITED:
+		return "SRV_EXITED"
 	}
 	return fmt.Sprintf("%d", status)
 }
@@ -89,6 +96,24 @@ type command struct {
 	arguments    []string
 	environments []string
 	directory    string
+	limits       []rlimit
+	credential   *credential
+}
+
+// fingerprint 返回命令实际执行的内容， 用于计算配置的摘要
+func (self *command) fingerprint() []interface{} {
+	if nil == self {
+		return nil
+	}
+	limits := make([]string, 0, len(self.limits))
+	for idx := range self.limits {
+		limits = append(limits, self.limits[idx].String())
+	}
+	var cred string
+	if nil != self.credential {
+		cred = self.credential.encode()
+	}
+	return []interface{}{self.proc, self.arguments, self.environments, self.directory, limits, cred}
 }
 
 func (self *command) command(mode string) *exec.Cmd {
@@ -110,6 +135,9 @@ func (self *command) command(mode string) *exec.Cmd {
 		}
 		environments = append(environments, os_env...)
 		cmd.Env = environments
+		setProcessGroup(cmd)
+		applyRlimits(cmd, self.limits)
+		applyCredential(cmd, self.credential)
 		return cmd
 	}
 }
@@ -123,15 +151,23 @@ type Status struct {
 type supervisor interface {
 	fileName() string
 	name() string
+	dependencies() []string
+	instanceOf() string
+	memberOf(group string) bool
+	fingerprint() string
 	start() bool
 	stop() bool
+	reload() error
+	signal(name string, group bool) (string, error)
 	isMode(mode string) bool
 	untilStarted() error
 	untilStopped() error
 
 	setManager(mgr *Manager)
 	setOutput(out io.Writer)
+	logOutput() io.Writer
 	stats() map[string]interface{}
+	logOwner() (uid, gid int, ok bool)
 	GetStatus() Status
 }
 
@@ -143,11 +179,24 @@ type supervisorBase struct {
 	killTimeout     time.Duration
 	start_cmd       *command
 	stop_cmd        *command
+	reload_cmd      *command
+	escalation      []signalStep
 	mode            string
 	out             io.Writer
 	srv_status      int32
 	on              func(string, int32)
 	restartSchedule string
+	dependsOn       []string
+
+	// instances 展开后的实例所属的组名和序号
+	group    string
+	instance int
+	// groups 是服务所属的服务组， 用于按组滚动重启
+	groups []string
+	// configHash 用于重新加载配置时判断服务的配置是否改变了
+	configHash    string
+	reloadConfigs func() (*reloadReport, error)
+	alert         func(name, message string)
 
 	cleansBefore []string
 }
@@ -176,6 +225,8 @@ func (self *supervisorBase) cleanBefore() {
 
 func (self *supervisorBase) setManager(mgr *Manager) {
 	self.cr = mgr.cr
+	self.reloadConfigs = mgr.reloadConfigs
+	self.alert = mgr.onAlert
 }
 
 func (self *supervisorBase) onEvent(status int32) {
@@ -184,6 +235,12 @@ func (self *supervisorBase) onEvent(status int32) {
 	}
 }
 
+func (self *supervisorBase) onAlert(message string) {
+	if self.alert != nil {
+		self.alert(self.name(), message)
+	}
+}
+
 func (self *supervisorBase) fileName() string {
 	return self.file
 }
@@ -205,22 +262,76 @@ func (self *supervisorBase) name() string {
 	return self.proc_name
 }
 
+func (self *supervisorBase) dependencies() []string {
+	return self.dependsOn
+}
+
+func (self *supervisorBase) instanceOf() string {
+	return self.group
+}
+
+func (self *supervisorBase) memberOf(group string) bool {
+	if "" != self.group && self.group == group {
+		return true
+	}
+	for _, nm := range self.groups {
+		if nm == group {
+			return true
+		}
+	}
+	return false
+}
+
 func (self *supervisorBase) stats() map[string]interface{} {
 	status := atomic.LoadInt32(&self.srv_status)
-	return map[string]interface{}{
+	res := map[string]interface{}{
 		"name":         self.proc_name,
+		"depends_on":   self.dependsOn,
 		"retries":      self.retries,
 		"kill_timeout": self.killTimeout,
 		"owned":        true,
-		"is_started":   (status != SRV_INIT) && (status != SRV_STOPPING),
+		"is_started":   (status != SRV_INIT) && (status != SRV_STOPPING) && (status != SRV_EXITED),
 		"srv_status":   srvString(status),
+		"reloadable":   nil != self.reload_cmd,
+	}
+	if "" != self.group {
+		res["instance_of"] = self.group
+		res["instance"] = self.instance
+	}
+	if 0 != len(self.groups) {
+		res["groups"] = self.groups
+	}
+	if nil != self.start_cmd && nil != self.start_cmd.credential {
+		res["user"] = self.start_cmd.credential.String()
+	}
+	return res
+}
+
+// logOwner 返回日志文件的所有者， 它和子进程的用户和组相同
+func (self *supervisorBase) logOwner() (uid, gid int, ok bool) {
+	if nil == self.start_cmd || nil == self.start_cmd.credential {
+		return -1, -1, false
 	}
+	return int(self.start_cmd.credential.uid), int(self.start_cmd.credential.gid), true
 }
 
+// setOutput 设置服务的日志， 进程的输出和健康检查等 goroutine 都会写它，
+// 所以写之前要加锁
 func (self *supervisorBase) setOutput(out io.Writer) {
+	if _, ok := out.(*safe_writer); !ok && nil != out {
+		out = safe_io(out)
+	}
 	self.out = out
 }
 
+func (self *supervisorBase) logOutput() io.Writer {
+	return self.out
+}
+
+func (self *supervisorBase) fingerprint() string {
+	return self.configHash
+}
+
 func waitWithTimeout(timeout time.Duration, pr *os.Process) error {
 	errc := make(chan error, 1)
 	go func() {
@@ -242,31 +353,92 @@ func (self *supervisorBase) killBySignal(pid int) (bool, string) {
 		return false, "signal is empty"
 	}
 
-	var sig os.Signal
-	switch self.stop_cmd.arguments[0] {
-	case "kill":
-		sig = os.Kill
-		break
-	case "int":
-		sig = os.Interrupt
-		break
-	default:
-		return false, "signal '" + self.stop_cmd.arguments[0] + "' is unsupported"
+	steps, e := parseSignalSequence(self.stop_cmd.arguments, self.killTimeout)
+	if nil != e {
+		return false, e.Error()
 	}
+	return self.killBySequence(pid, steps)
+}
 
+// killBySequence 依次发送 steps 中的信号， 每发送一个信号就等待进程退出，
+// 超时后再发送下一个。
+func (self *supervisorBase) killBySequence(pid int, steps []signalStep) (bool, string) {
 	pr, e := os.FindProcess(pid)
 	if nil != e {
 		return false, e.Error()
 	}
-	e = pr.Signal(sig)
-	if nil != e {
-		return false, e.Error()
+	defer pr.Release()
+
+	var txt string
+	for _, step := range steps {
+		if e = signalGroup(pid, step.sig); nil != e {
+			e = pr.Signal(step.sig)
+			if e == os.ErrProcessDone {
+				return true, txt
+			}
+			if nil != e {
+				return false, txt + "[sys] send " + step.name + " failed, " + e.Error()
+			}
+		}
+		txt += "[sys] send " + step.name + " to pid(" + strconv.Itoa(pid) + ")\r\n"
+
+		e = waitExit(pr, step.wait)
+		if nil == e {
+			return true, txt
+		}
+		txt += "[sys] " + e.Error() + "\r\n"
 	}
-	e = waitWithTimeout(self.killTimeout, pr)
-	if nil != e {
-		return false, e.Error()
+	return false, txt
+}
+
+// escalate 在停止命令失败后按 escalation 停止进程
+func (self *supervisorBase) escalate(pid int) (bool, string) {
+	steps := self.escalation
+	if 0 == len(steps) {
+		steps = defaultEscalation(self.killTimeout)
+	}
+	return self.killBySequence(pid, steps)
+}
+
+// stopSignal 返回停止进程时发送的第一个信号， stop 不是 __signal__ 时为
+// escalation 的第一个信号
+func (self *supervisorBase) stopSignal() signalStep {
+	if nil != self.stop_cmd && "__signal__" == self.stop_cmd.proc {
+		if steps, e := parseSignalSequence(self.stop_cmd.arguments, self.killTimeout); nil == e {
+			return steps[0]
+		}
+	}
+	steps := self.escalation
+	if 0 == len(steps) {
+		steps = defaultEscalation(self.killTimeout)
+	}
+	return steps[0]
+}
+
+// stopGroup 停止进程组 pgid 中残留的进程， 先向整个组发送停止信号， 等待
+// killTimeout 后仍然没有退出的再用 SIGKILL 杀死
+func (self *supervisorBase) stopGroup(pgid int) {
+	if !groupExists(pgid) {
+		return
+	}
+
+	step := self.stopSignal()
+	if e := signalPgid(pgid, step.sig); nil != e {
+		self.logString("[sys] send " + step.name + " to the rest of process group failed, " + e.Error() + "\r\n")
+	} else {
+		self.logString("[sys] send " + step.name + " to the rest of process group(" + strconv.Itoa(pgid) + ")\r\n")
+	}
+
+	deadline := time.Now().Add(self.killTimeout)
+	for groupExists(pgid) && time.Now().Before(deadline) {
+		time.Sleep(50 * time.Millisecond)
+	}
+	if !groupExists(pgid) {
+		return
+	}
+	if e := killGroup(pgid); nil == e {
+		self.logString("[sys] kill the rest of process group\r\n")
 	}
-	return true, ""
 }
 
 func (self *supervisorBase) killByCmd(pid int) (bool, string) {
@@ -301,6 +473,85 @@ func (self *supervisorBase) killByCmd(pid int) (bool, string) {
 	return true, ""
 }
 
+// reloadBy 让进程重新加载配置， reload 为 __signal__ 时向主进程发送信号(默认
+// 为 hup)， 否则执行 reload 命令， 命令中可以通过环境变量 DAEMON_PID 得到 pid。
+// 它不会改变服务的状态。
+func (self *supervisorBase) reloadBy(pid int) error {
+	if nil == self.reload_cmd {
+		return errors.New("'" + self.proc_name + "' is not support reload.")
+	}
+	if 0 == pid {
+		return errors.New("'" + self.proc_name + "' is not running.")
+	}
+
+	if "__signal__" == self.reload_cmd.proc {
+		name, sig, e := "SIGHUP", signalNames["HUP"], error(nil)
+		if 0 != len(self.reload_cmd.arguments) {
+			name, sig, e = parseSignal(self.reload_cmd.arguments[0])
+			if nil != e {
+				return e
+			}
+		}
+		if nil == sig {
+			return errors.New("signal 'hup' is unsupported")
+		}
+
+		pr, e := os.FindProcess(pid)
+		if nil != e {
+			return e
+		}
+		defer pr.Release()
+		if e = pr.Signal(sig); nil != e {
+			return errors.New("send " + name + " to pid(" + strconv.Itoa(pid) + ") failed, " + e.Error())
+		}
+		self.logString("[sys] reload - send " + name + " to pid(" + strconv.Itoa(pid) + ")\r\n")
+		return nil
+	}
+
+	cmd := self.reload_cmd.command(self.mode)
+	cmd.Env = append(cmd.Env, "DAEMON_PID="+strconv.Itoa(pid))
+	output, e := outputWithTimeout(self.killTimeout, cmd)
+	if 0 != len(output) {
+		self.logString("[sys] reload - " + string(output) + "\r\n")
+	}
+	if nil != e {
+		return errors.New("reload '" + self.proc_name + "' failed, " + e.Error())
+	}
+	self.logString("[sys] reload - ok\r\n")
+	return nil
+}
+
+// sendSignal 向 pid 或以它为组长的进程组发送信号， 返回发送了什么信号给谁
+func (self *supervisorBase) sendSignal(pid int, name string, group bool) (string, error) {
+	if 0 == pid {
+		return "", errors.New("'" + self.proc_name + "' is not running.")
+	}
+	name, sig, e := parseSignal(name)
+	if nil != e {
+		return "", e
+	}
+
+	var txt string
+	if group {
+		if e = signalGroup(pid, sig); nil != e {
+			return "", errors.New("send " + name + " to process group(" + strconv.Itoa(pid) + ") failed, " + e.Error())
+		}
+		txt = "send " + name + " to process group(" + strconv.Itoa(pid) + ")"
+	} else {
+		pr, e := os.FindProcess(pid)
+		if nil != e {
+			return "", e
+		}
+		defer pr.Release()
+		if e = pr.Signal(sig); nil != e {
+			return "", errors.New("send " + name + " to pid(" + strconv.Itoa(pid) + ") failed, " + e.Error())
+		}
+		txt = "send " + name + " to pid(" + strconv.Itoa(pid) + ")"
+	}
+	self.logString("[sys] " + txt + "\r\n")
+	return txt, nil
+}
+
 func (self *supervisorBase) logRotateToErrorFile() {
 	if nil == self.out {
 		// self.logString("logRotateToErrorFile() but out is nil")
@@ -0,0 +1,190 @@
+package daemontools
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/runner-mei/cron"
+)
+
+const (
+	OVERLAP_SKIP  = "skip"
+	OVERLAP_QUEUE = "queue"
+	OVERLAP_KILL  = "kill"
+
+	// maxQueuedRuns 是 overlap 为 queue 时最多排队的次数
+	maxQueuedRuns = 16
+)
+
+func toOverlapPolicy(s string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", OVERLAP_SKIP:
+		return OVERLAP_SKIP, nil
+	case OVERLAP_QUEUE:
+		return OVERLAP_QUEUE, nil
+	case OVERLAP_KILL, "replace":
+		return OVERLAP_KILL, nil
+	}
+	return "", errors.New("overlap policy '" + s + "' is unsupported")
+}
+
+// cronJob 是 type 为 cron 的服务的调度设置
+type cronJob struct {
+	schedule   string
+	overlap    string
+	runTimeout time.Duration
+
+	id string
+}
+
+func loadCronJob(args map[string]interface{}) (*cronJob, error) {
+	schedule := strings.TrimSpace(stringWithDefault(args, "schedule", ""))
+	if "" == schedule {
+		return nil, errors.New("'schedule' is missing.")
+	}
+	if _, e := cron.Parse(schedule); nil != e {
+		return nil, errors.New("'schedule' is invalid, " + e.Error())
+	}
+	overlap, e := toOverlapPolicy(stringWithDefault(args, "overlap", ""))
+	if nil != e {
+		return nil, e
+	}
+	runTimeout := durationWithDefault(args, "timeout", 0)
+	if runTimeout < 0 {
+		return nil, errors.New("'timeout' must is greate or equal 0s.")
+	}
+	return &cronJob{schedule: schedule, overlap: overlap, runTimeout: runTimeout}, nil
+}
+
+// onTick 在 cron 的 goroutine 中被调用， 它不能阻塞
+func (self *supervisor_default) onTick(ticks chan time.Time) {
+	now := time.Now()
+	isRunning := PROC_INIT != atomic.LoadInt32(&self.proc_status)
+
+	switch self.cron.overlap {
+	case OVERLAP_SKIP:
+		if isRunning {
+			self.logString("[sys] previous run is not finished, skip it.\r\n")
+			return
+		}
+	case OVERLAP_KILL:
+		if isRunning {
+			self.logString("[sys] previous run is not finished, kill it.\r\n")
+			go self.interruptBy(EXIT_REASON_OVERLAP)
+		}
+	}
+
+	select {
+	case ticks <- now:
+	default:
+		self.logString("[sys] too many runs is queued, skip it.\r\n")
+	}
+}
+
+// cronLoop 是 type 为 cron 的服务的主循环， 服务启动后按 schedule 运行命令，
+// 服务本身一直处于 SRV_RUNNING 状态直到被停止。
+func (self *supervisor_default) cronLoop() {
+	defer func() {
+		self.setStatus(SRV_INIT)
+		atomic.StoreInt32(&self.proc_status, PROC_INIT)
+		self.logString("[sys] ====================  srv  end  ====================\r\n")
+	}()
+
+	self.logString("[sys] ==================== srv  start ====================\r\n")
+
+	self.cond.L.Lock()
+	stopc := self.stopc
+	self.cond.L.Unlock()
+
+	size := 1
+	if OVERLAP_QUEUE == self.cron.overlap {
+		size = maxQueuedRuns
+	}
+	ticks := make(chan time.Time, size)
+
+	var jobID = self.name() + "-" + time.Now().Format(time.RFC3339Nano)
+	self.cond.L.Lock()
+	self.cron.id = jobID
+	self.cond.L.Unlock()
+
+	if nil == self.cr {
+		self.cond.L.Lock()
+		self.startError = errors.New("start '" + self.name() + "' failed, cron is not found.")
+		self.cond.L.Unlock()
+		return
+	}
+	if e := self.cr.AddFunc(jobID, self.cron.schedule, func() {
+		self.onTick(ticks)
+	}); nil != e {
+		self.logString("[sys] " + e.Error() + "\r\n")
+		self.cond.L.Lock()
+		self.startError = errors.New("start '" + self.name() + "' failed, " + e.Error())
+		self.cond.L.Unlock()
+		return
+	}
+	defer self.cr.Unschedule(jobID)
+	self.logString("[sys] schedule is '" + self.cron.schedule + "'\r\n")
+
+	if !self.casStatus(SRV_STARTING, SRV_RUNNING) {
+		return
+	}
+
+	for {
+		select {
+		case <-stopc:
+			return
+		case <-ticks:
+		}
+
+		if SRV_RUNNING != atomic.LoadInt32(&self.srv_status) {
+			return
+		}
+
+		var timer *time.Timer
+		if self.cron.runTimeout > 0 {
+			timer = time.AfterFunc(self.cron.runTimeout, func() {
+				self.logString(fmt.Sprintf("[sys] run is timed out after %v, kill it.\r\n", self.cron.runTimeout))
+				self.interruptBy(EXIT_REASON_TIMEOUT)
+			})
+		}
+		record := self.run(nil)
+		if nil != timer {
+			timer.Stop()
+		}
+		if EXIT_REASON_EXITED != record.Reason {
+			self.logString("[sys] run is failed - " + record.String() + "\r\n")
+		}
+	}
+}
+
+func (self *supervisor_default) cronStats(res map[string]interface{}) {
+	res["schedule"] = self.cron.schedule
+	res["overlap"] = self.cron.overlap
+	if self.cron.runTimeout > 0 {
+		res["timeout"] = self.cron.runTimeout.String()
+	}
+
+	self.cond.L.Lock()
+	jobID := self.cron.id
+	last := self.history.last()
+	self.cond.L.Unlock()
+
+	if nil != last {
+		res["last_run_at"] = last.StartAt
+		res["last_run_duration"] = last.EndAt.Sub(last.StartAt).String()
+		res["last_run_result"] = last.String()
+	}
+	if nil != self.cr && "" != jobID && SRV_RUNNING == atomic.LoadInt32(&self.srv_status) {
+		for _, entry := range self.cr.Entries() {
+			if jobID == entry.Id {
+				if !entry.Next.IsZero() {
+					res["next_run_at"] = entry.Next
+				}
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package daemontools
+
+import (
+	"runtime"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/runner-mei/cron"
+)
+
+func TestLoadCronJob(t *testing.T) {
+	job, e := loadCronJob(map[string]interface{}{"schedule": "0 */5 * * * ?", "overlap": "queue", "timeout": "1m"})
+	if nil != e {
+		t.Fatal(e)
+	}
+	if OVERLAP_QUEUE != job.overlap || time.Minute != job.runTimeout {
+		t.Errorf("%#v", job)
+	}
+
+	for _, args := range []map[string]interface{}{{},
+		{"schedule": "abc"},
+		{"schedule": "@hourly", "overlap": "abc"}} {
+		if _, e := loadCronJob(args); nil == e {
+			t.Error(args, "excepted error")
+		}
+	}
+}
+
+func TestCronJob(t *testing.T) {
+	if "windows" == runtime.GOOS {
+		t.Skip("sh is required")
+	}
+
+	cr := cron.New()
+	cr.Start()
+	defer cr.Stop()
+
+	newJob := func(buffer *lockedBuffer, name, overlap string, timeout time.Duration) *supervisor_default {
+		return &supervisor_default{serviceType: SERVICE_CRON,
+			cron: &cronJob{schedule: "* * * * * ?", overlap: overlap, runTimeout: timeout},
+			supervisorBase: supervisorBase{proc_name: name,
+				cr:          cr,
+				retries:     1,
+				killTimeout: time.Second,
+				out:         buffer,
+				start_cmd:   &command{proc: "sh", arguments: []string{"-c", "echo tick; sleep 2"}}}}
+	}
+
+	var timeoutBuffer, skipBuffer, queueBuffer, killBuffer lockedBuffer
+	timeoutJob := newJob(&timeoutBuffer, "test_cron_timeout", OVERLAP_SKIP, 500*time.Millisecond)
+	skipJob := newJob(&skipBuffer, "test_cron_skip", OVERLAP_SKIP, 0)
+	queueJob := newJob(&queueBuffer, "test_cron_queue", OVERLAP_QUEUE, 0)
+	killJob := newJob(&killBuffer, "test_cron_kill", OVERLAP_KILL, 0)
+	for _, s := range []*supervisor_default{timeoutJob, skipJob, queueJob, killJob} {
+		s.start()
+		if e := s.untilStarted(); nil != e {
+			t.Fatal(e)
+		}
+		defer func(s *supervisor_default) {
+			s.stop()
+			s.untilStopped()
+		}(s)
+	}
+
+	stats := timeoutJob.stats()
+	if _, ok := stats["next_run_at"]; !ok {
+		t.Errorf("next_run_at is missing - %#v", stats)
+	}
+
+	// untilRuns 等待任务至少运行 n 次， 超时返回 false
+	untilRuns := func(s *supervisor_default, n int) ([]runRecord, bool) {
+		for i := 0; i < 200; i++ {
+			if runs := s.stats()["runs"].([]runRecord); len(runs) >= n {
+				return runs, true
+			}
+			time.Sleep(50 * time.Millisecond)
+		}
+		return s.stats()["runs"].([]runRecord), false
+	}
+
+	runs, ok := untilRuns(timeoutJob, 1)
+	if !ok || EXIT_REASON_TIMEOUT != runs[0].Reason {
+		t.Errorf("runs is invalid - %#v", runs)
+		t.Error(timeoutBuffer.String())
+	}
+	if _, ok := timeoutJob.stats()["last_run_at"]; !ok {
+		t.Error("last_run_at is missing")
+	}
+	if SRV_RUNNING != atomic.LoadInt32(&timeoutJob.srv_status) {
+		t.Error("status is", srvString(atomic.LoadInt32(&timeoutJob.srv_status)))
+	}
+
+	// 上一次运行没有结束时， kill 会终止它
+	runs, ok = untilRuns(killJob, 1)
+	if !ok || EXIT_REASON_OVERLAP != runs[0].Reason {
+		t.Errorf("runs is invalid - %#v", runs)
+		t.Error(killBuffer.String())
+	}
+	if ss := killBuffer.String(); !strings.Contains(ss, "kill it") {
+		t.Error(ss)
+	}
+
+	// 上一次运行没有结束时， queue 会等它结束后再运行， 不会跳过也不会终止它
+	runs, ok = untilRuns(queueJob, 2)
+	if !ok {
+		t.Errorf("runs is invalid - %#v", runs)
+		t.Error(queueBuffer.String())
+	}
+	for _, record := range runs {
+		if EXIT_REASON_EXITED != record.Reason {
+			t.Errorf("run is invalid - %#v", record)
+		}
+	}
+	if ss := queueBuffer.String(); strings.Contains(ss, "skip it") || strings.Contains(ss, "kill it") {
+		t.Error(ss)
+	}
+
+	for i := 0; i < 200 && !strings.Contains(skipBuffer.String(), "skip it"); i++ {
+		time.Sleep(50 * time.Millisecond)
+	}
+	if ss := skipBuffer.String(); !strings.Contains(ss, "skip it") || !strings.Contains(ss, "tick") {
+		t.Error(ss)
+	}
+}
+
+func TestCronJobQueueIsFull(t *testing.T) {
+	var buffer lockedBuffer
+	s := &supervisor_default{serviceType: SERVICE_CRON,
+		cron: &cronJob{schedule: "* * * * * ?", overlap: OVERLAP_QUEUE},
+		supervisorBase: supervisorBase{proc_name: "test_cron_queue_full",
+			out: &buffer}}
+	atomic.StoreInt32(&s.proc_status, PROC_RUNNING)
+
+	// 排队的次数达到 maxQueuedRuns 后， 后面的会被跳过
+	ticks := make(chan time.Time, maxQueuedRuns)
+	for i := 0; i < maxQueuedRuns+2; i++ {
+		s.onTick(ticks)
+	}
+	if maxQueuedRuns != len(ticks) {
+		t.Error("excepted queued is", maxQueuedRuns, ", actual is", len(ticks))
+	}
+	if ss := buffer.String(); 2 != strings.Count(ss, "too many runs is queued") {
+		t.Error(ss)
+	}
+}
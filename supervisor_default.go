@@ -7,20 +7,101 @@ import (
 	"io"
 	"log"
 	"os"
+	"regexp"
 	"runtime"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 )
 
+const (
+	RESTART_ALWAYS     = "always"
+	RESTART_ON_FAILURE = "on-failure"
+	RESTART_NEVER      = "never"
+)
+
+const (
+	SERVICE_SIMPLE  = "simple"
+	SERVICE_ONESHOT = "oneshot"
+	SERVICE_CRON    = "cron"
+)
+
+func toServiceType(s string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", SERVICE_SIMPLE:
+		return SERVICE_SIMPLE, nil
+	case SERVICE_ONESHOT:
+		return SERVICE_ONESHOT, nil
+	case SERVICE_CRON:
+		return SERVICE_CRON, nil
+	}
+	return "", errors.New("type '" + s + "' is unsupported")
+}
+
+func toRestartPolicy(s string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", RESTART_ALWAYS, "unless-stopped", "unless_stopped":
+		// 通过 API 停止的服务会保存在 settings_file 中， 重启后仍然保持停止， 所以
+		// always 已经是 docker 中 unless-stopped 的行为了
+		return RESTART_ALWAYS, nil
+	case RESTART_ON_FAILURE, "on_failure":
+		return RESTART_ON_FAILURE, nil
+	case RESTART_NEVER, "no":
+		return RESTART_NEVER, nil
+	}
+	return "", errors.New("restart policy '" + s + "' is unsupported")
+}
+
 type supervisor_default struct {
 	supervisorBase
+	serviceType  string
+	cron         *cronJob
 	success_flag string
+	successFlags []*regexp.Regexp
+	failureFlags []*regexp.Regexp
+
+	restartPolicy    string
+	successExitCodes []int
+
+	restartDelay         time.Duration
+	restartDelayMax      time.Duration
+	restartBackoffFactor float64
+	restartBackoffReset  time.Duration
+	currentDelay         int64
 
 	proc_status int32
 	pid         int
 	stdin       io.WriteCloser
+	stopc       chan struct{}
+	history     runHistory
+
+	// interruptReason 是主动终止进程的原因， 为空表示进程是自己退出的
+	interruptReason  string
+	interruptMessage string
+
+	startTimeout time.Duration
+	startError   error
+
+	cgroup *cgroupConfig
+
+	watchdogSilence time.Duration
+	lastOutputAt    int64
+
+	// output 保留 oneshot 服务最后一次运行的输出
+	output *tailBuffer
+
+	metricsInterval time.Duration
+	metrics         processMetrics
+	thresholds      *thresholds
+
+	// watch 中的文件改变后重启服务
+	watch *fileWatch
+
+	ready       *readyCheck
+	healthcheck *healthCheck
+	health      healthState
 
 	lock sync.Mutex
 	cond *sync.Cond
@@ -62,6 +143,8 @@ func (self *supervisor_default) stats() map[string]interface{} {
 	pid := 0
 	self.cond.L.Lock()
 	pid = self.pid
+	runs := self.history.list()
+	last := self.history.last()
 	self.cond.L.Unlock()
 
 	srv_status := atomic.LoadInt32(&self.srv_status)
@@ -69,7 +152,75 @@ func (self *supervisor_default) stats() map[string]interface{} {
 	res := self.supervisorBase.stats()
 
 	res["pid"] = pid
+	res["runs"] = runs
+	if 0 != len(self.start_cmd.limits) {
+		res["limits"] = effectiveRlimits(pid, self.start_cmd.limits)
+	}
+	if nil != self.cgroup {
+		res["cgroup"] = self.cgroup.stats()
+	}
+	if self.startTimeout > 0 {
+		res["start_timeout"] = self.startTimeout.String()
+	}
+	if self.watchdogSilence > 0 {
+		res["watchdog_silence"] = self.watchdogSilence.String()
+	}
+	if at := atomic.LoadInt64(&self.lastOutputAt); 0 != at {
+		res["last_output_at"] = time.Unix(0, at)
+	}
+	if nil != self.ready {
+		res["ready"] = self.ready.probe.String()
+	}
+	if nil != self.healthcheck {
+		res["health"] = self.health.stats()
+	}
+	if metrics := self.metrics.stats(); nil != metrics {
+		res["metrics"] = metrics
+	}
+	if nil != self.thresholds {
+		res["thresholds"] = self.thresholds.stats()
+	}
+	if nil != self.watch {
+		res["watch"] = self.watch.patterns
+		res["watch_config"] = self.watch.config
+	}
+	if nil != last {
+		res["last_exit"] = last.Reason
+		if EXIT_REASON_EXITED != last.Reason {
+			res["last_error"] = last.String()
+			res["last_error_summary"] = last.Reason
+		}
+	}
 	res["success_flag"] = self.success_flag
+	if 0 != len(self.successFlags) {
+		res["success_flags"] = regexpStrings(self.successFlags)
+	}
+	if 0 != len(self.failureFlags) {
+		res["failure_flags"] = regexpStrings(self.failureFlags)
+	}
+	res["type"] = self.serviceType
+	if nil != self.cron {
+		self.cronStats(res)
+	}
+	if SERVICE_ONESHOT == self.serviceType && nil != last && SRV_STARTING != srv_status {
+		result := map[string]interface{}{
+			"exit_code":   last.ExitCode,
+			"reason":      last.Reason,
+			"success":     self.isSuccessRun(*last),
+			"started_at":  last.StartAt,
+			"finished_at": last.EndAt,
+			"duration":    last.EndAt.Sub(last.StartAt).String(),
+		}
+		if nil != self.output {
+			result["log"] = self.output.String()
+		}
+		res["result"] = result
+	}
+	res["restart"] = self.restartPolicy
+	res["success_exit_codes"] = self.successExitCodes
+	res["restart_delay"] = time.Duration(atomic.LoadInt64(&self.currentDelay)).String()
+	res["restart_delay_max"] = self.restartDelayMax.String()
+	res["restart_backoff_factor"] = self.restartBackoffFactor
 	res["status"] = statusString(srv_status, proc_status)
 	res["srv_status"] = srvString(srv_status)
 	res["proc_status"] = procString(proc_status)
@@ -91,10 +242,92 @@ func (self *supervisor_default) setStatus(new_status int32) {
 }
 
 func (self *supervisor_default) untilStarted() error {
-	return self.untilWith(SRV_STARTING, SRV_RUNNING)
+	e := self.untilWith(SRV_STARTING, SRV_RUNNING)
+	if nil != e {
+		self.cond.L.Lock()
+		startError := self.startError
+		self.cond.L.Unlock()
+		if nil != startError {
+			return startError
+		}
+		// oneshot 服务成功退出后就是 SRV_EXITED
+		if SERVICE_ONESHOT == self.serviceType && SRV_EXITED == atomic.LoadInt32(&self.srv_status) {
+			return nil
+		}
+	}
+	return e
+}
+
+func (self *supervisor_default) trackOutput(out io.Writer) io.Writer {
+	return &activityWriter{out: out, lastAt: &self.lastOutputAt, tail: self.output}
+}
+
+// watchdogLoop 在进程超过 watchdogSilence 没有任何输出时重启它， 进程退出时
+// done 会被关闭。
+func (self *supervisor_default) watchdogLoop(done <-chan struct{}) {
+	interval := self.watchdogSilence / 4
+	if interval > 10*time.Second {
+		interval = 10 * time.Second
+	} else if interval < 10*time.Millisecond {
+		interval = 10 * time.Millisecond
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+
+		if PROC_RUNNING != atomic.LoadInt32(&self.proc_status) {
+			continue
+		}
+		silence := time.Now().Sub(time.Unix(0, atomic.LoadInt64(&self.lastOutputAt)))
+		if silence >= self.watchdogSilence {
+			self.logString("[sys] watchdog: no output for " + silence.String() + ", restart it.\r\n")
+			self.interruptBy(EXIT_REASON_WATCHDOG)
+			return
+		}
+	}
+}
+
+func (self *supervisor_default) setStartError(record runRecord) {
+	var e error
+	switch record.Reason {
+	case EXIT_REASON_START_TIMEOUT:
+		e = fmt.Errorf("start '%s' is timed out after %v", self.name(), self.startTimeout)
+	case "":
+		return
+	default:
+		e = fmt.Errorf("start '%s' failed, %s", self.name(), record.String())
+	}
+
+	self.cond.L.Lock()
+	self.startError = e
+	self.cond.L.Unlock()
+}
+
+// startTimeoutLoop 在进程超过 startTimeout 仍未就绪时终止它， 进程退出时
+// done 会被关闭， 就绪时 readyc 会被关闭。
+func (self *supervisor_default) startTimeoutLoop(done, readyc <-chan struct{}) {
+	timer := time.NewTimer(self.startTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-done:
+	case <-readyc:
+	case <-timer.C:
+		self.logString("[sys] process is not ready after " + self.startTimeout.String() + ", kill it.\r\n")
+		self.interruptBy(EXIT_REASON_START_TIMEOUT)
+	}
 }
 
 func (self *supervisor_default) untilStopped() error {
+	if SRV_EXITED == atomic.LoadInt32(&self.srv_status) {
+		return nil
+	}
 	return self.untilWith(SRV_STOPPING, SRV_INIT)
 }
 
@@ -128,7 +361,12 @@ func (self *supervisor_default) init() {
 func (self *supervisor_default) start() bool {
 	self.init()
 
-	if !self.casStatus(SRV_INIT, SRV_STARTING) {
+	self.cond.L.Lock()
+	self.startError = nil
+	self.cond.L.Unlock()
+
+	if !self.casStatus(SRV_INIT, SRV_STARTING) &&
+		!self.casStatus(SRV_EXITED, SRV_STARTING) {
 		if err := self.untilStopped(); err != nil {
 			log.Println("[system]", err)
 			return false
@@ -138,7 +376,24 @@ func (self *supervisor_default) start() bool {
 		}
 	}
 
-	go self.loop()
+	stopc := make(chan struct{})
+	self.cond.L.Lock()
+	// 从 SRV_EXITED 直接启动时上一次的 stopc 还没有关闭
+	if nil != self.stopc {
+		close(self.stopc)
+	}
+	self.stopc = stopc
+	self.cond.L.Unlock()
+
+	if nil != self.watch {
+		// 快照要在 start 返回前生成， 否则启动后马上修改的文件可能不会被发现
+		go self.watchLoop(stopc, self.watch.snapshot(self.watchPatterns()))
+	}
+	if SERVICE_CRON == self.serviceType {
+		go self.cronLoop()
+	} else {
+		go self.loop()
+	}
 
 	return true
 }
@@ -146,25 +401,182 @@ func (self *supervisor_default) start() bool {
 func (self *supervisor_default) stop() bool {
 	self.init()
 	self.logString(time.Now().String() + " [sys]swithing to '" + srvString(atomic.LoadInt32(&self.srv_status)) + "'\r\n")
+	if self.casStatus(SRV_EXITED, SRV_INIT) {
+		// 进程已经退出了， 但 watchLoop 等仍在等待 stopc
+		self.cond.L.Lock()
+		if nil != self.stopc {
+			close(self.stopc)
+			self.stopc = nil
+		}
+		self.cond.L.Unlock()
+		return true
+	}
 	if !self.casStatus(SRV_RUNNING, SRV_STOPPING) &&
 		!self.casStatus(SRV_STARTING, SRV_STOPPING) {
 		return false
 	}
 	self.logString(time.Now().String() + " [sys]swith to '" + srvString(atomic.LoadInt32(&self.srv_status)) + "'\r\n")
+
+	self.cond.L.Lock()
+	if nil != self.stopc {
+		close(self.stopc)
+		self.stopc = nil
+	}
+	self.cond.L.Unlock()
+
 	go self.interrupt()
 	return true
 }
 
+// reload 让正在运行的进程重新加载配置， 进程没有运行时返回错误
+func (self *supervisor_default) reload() error {
+	self.init()
+
+	self.cond.L.Lock()
+	pid := self.pid
+	self.cond.L.Unlock()
+
+	if PROC_RUNNING != atomic.LoadInt32(&self.proc_status) {
+		pid = 0
+	}
+	return self.reloadBy(pid)
+}
+
+// signal 向正在运行的进程发送信号， group 为 true 时发送给整个进程组
+func (self *supervisor_default) signal(name string, group bool) (string, error) {
+	self.init()
+
+	self.cond.L.Lock()
+	pid := self.pid
+	self.cond.L.Unlock()
+
+	if PROC_RUNNING != atomic.LoadInt32(&self.proc_status) {
+		pid = 0
+	}
+	return self.sendSignal(pid, name, group)
+}
+
+// shouldRestart 根据重启策略和退出码判断进程退出后是否需要重启
+func (self *supervisor_default) shouldRestart(exitCode int) bool {
+	switch self.restartPolicy {
+	case RESTART_NEVER:
+		return false
+	case RESTART_ON_FAILURE:
+		if exitCode < 0 {
+			return true
+		}
+		return !self.isSuccessExit(exitCode)
+	default:
+		return true
+	}
+}
+
+func (self *supervisor_default) isSuccessExit(exitCode int) bool {
+	successExitCodes := self.successExitCodes
+	if 0 == len(successExitCodes) {
+		successExitCodes = []int{0}
+	}
+	for _, code := range successExitCodes {
+		if code == exitCode {
+			return true
+		}
+	}
+	return false
+}
+
+// isSuccessRun 判断进程是否自己退出并且退出码是允许的
+func (self *supervisor_default) isSuccessRun(record runRecord) bool {
+	return "" == record.Signal &&
+		(EXIT_REASON_EXITED == record.Reason || EXIT_REASON_CRASHED == record.Reason) &&
+		self.isSuccessExit(record.ExitCode)
+}
+
+// completeOneshot 在 oneshot 服务退出后检查它是否成功， 失败时设置启动错误
+func (self *supervisor_default) completeOneshot(record runRecord) {
+	if self.isSuccessRun(record) {
+		self.logString(fmt.Sprintf("[sys] oneshot is completed with code %d.\r\n", record.ExitCode))
+		return
+	}
+
+	self.logString("[sys] oneshot is failed - " + record.String() + "\r\n")
+	if "" == record.Reason {
+		record.Reason = EXIT_REASON_START_FAILED
+	}
+	self.setStartError(record)
+}
+
+// nextRestartDelay 计算下一次重启前的等待时间， 进程稳定运行超过
+// restartBackoffReset 后等待时间会被重置为 restartDelay。
+func (self *supervisor_default) nextRestartDelay(uptime time.Duration) time.Duration {
+	current := time.Duration(atomic.LoadInt64(&self.currentDelay))
+	if self.restartBackoffReset > 0 && uptime >= self.restartBackoffReset {
+		current = 0
+	}
+
+	if current <= 0 {
+		current = self.restartDelay
+	} else if self.restartBackoffFactor > 1 {
+		current = time.Duration(float64(current) * self.restartBackoffFactor)
+	}
+	if self.restartDelayMax > 0 && current > self.restartDelayMax {
+		current = self.restartDelayMax
+	}
+	atomic.StoreInt64(&self.currentDelay, int64(current))
+	return current
+}
+
+func (self *supervisor_default) sleep(delay time.Duration) {
+	if delay <= 0 {
+		return
+	}
+
+	self.cond.L.Lock()
+	stopc := self.stopc
+	self.cond.L.Unlock()
+	if nil == stopc {
+		return
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-stopc:
+	}
+}
+
 func (self *supervisor_default) interrupt() {
+	self.interruptBy(EXIT_REASON_KILLED)
+}
+
+func (self *supervisor_default) interruptBy(reason string) {
+	self.interruptWith(reason, "")
+}
+
+// interruptWith 终止进程， reason 和 message 会记录在这次运行的记录中
+func (self *supervisor_default) interruptWith(reason, message string) {
 	pid := 0
 	self.cond.L.Lock()
 	pid = self.pid
+	if 0 != pid && "" == self.interruptReason {
+		self.interruptReason = reason
+		self.interruptMessage = message
+	}
 	self.cond.L.Unlock()
 
 	if 0 == pid {
 		self.logString(time.Now().String() + " [sys] pid = 0\r\n")
 		return
 	}
+
+	if nil != self.cgroup {
+		defer func() {
+			if e := self.cgroup.killAll(); nil != e {
+				self.logString("[sys] " + e.Error() + "\r\n")
+			}
+		}()
+	}
+
 	var ok bool
 	var txt string
 
@@ -183,7 +595,17 @@ func (self *supervisor_default) interrupt() {
 		if 0 != len(txt) {
 			self.logString(txt)
 		}
+
+		// 停止命令失败后按 escalation 逐步升级信号
+		if !ok && "__signal__" != self.stop_cmd.proc {
+			ok, txt = self.escalate(pid)
+			if 0 != len(txt) {
+				self.logString(txt)
+			}
+		}
 		if ok {
+			// 主进程已经退出， 但它的进程组中可能还有残留的子进程
+			self.stopGroup(pid)
 			return
 		}
 	}
@@ -244,13 +666,18 @@ func (self *supervisor_default) killByConsole(pid int) (bool, string) {
 }
 
 func (self *supervisor_default) loop() {
+	isExited := false
 	defer func() {
 		self.cond.L.Lock()
 		self.stdin = nil
 		self.pid = 0
 		self.cond.L.Unlock()
 
-		self.setStatus(SRV_INIT)
+		if !isExited ||
+			(!self.casStatus(SRV_RUNNING, SRV_EXITED) &&
+				!self.casStatus(SRV_STARTING, SRV_EXITED)) {
+			self.setStatus(SRV_INIT)
+		}
 		atomic.StoreInt32(&self.proc_status, PROC_INIT)
 
 		if e := recover(); nil != e {
@@ -271,7 +698,9 @@ func (self *supervisor_default) loop() {
 
 	var jobID = self.name() + "-" + time.Now().Format(time.RFC3339Nano)
 	if self.restartSchedule != "" {
-		if err := self.cr.AddFunc(jobID, self.restartSchedule, self.interrupt); err != nil {
+		if err := self.cr.AddFunc(jobID, self.restartSchedule, func() {
+			self.interruptBy(EXIT_REASON_SCHEDULE)
+		}); err != nil {
 			self.logString("[sys] " + err.Error() + "\r\n")
 		} else {
 			defer self.cr.Unschedule(jobID)
@@ -289,6 +718,8 @@ func (self *supervisor_default) loop() {
 		maxRetries = 10
 	}
 
+	atomic.StoreInt64(&self.currentDelay, 0)
+
 	isRunning := true
 	retries := -1
 	for isRunning {
@@ -299,51 +730,75 @@ func (self *supervisor_default) loop() {
 		status := atomic.LoadInt32(&self.srv_status)
 		switch status {
 		case SRV_RUNNING:
-			time.Sleep(2 * time.Second)
 		case SRV_STARTING:
 			if retries >= maxRetries {
 				isRunning = false
 				break
 			}
-			onStartOk = func() {
-				self.casStatus(SRV_STARTING, SRV_RUNNING)
+			// oneshot 服务只有在成功退出后才算启动成功
+			if SERVICE_ONESHOT != self.serviceType {
+				onStartOk = func() {
+					self.casStatus(SRV_STARTING, SRV_RUNNING)
+				}
 			}
 		default:
 			isRunning = false
+		}
+		if !isRunning {
 			break
 		}
 
 		restartAt := time.Now()
 		self.logString(time.Now().String() + " [sys]current status is '" + srvString(atomic.LoadInt32(&self.srv_status)) + "'\r\n")
 
-		self.run(onStartOk)
+		record := self.run(onStartOk)
+
+		uptime := time.Now().Sub(restartAt)
+		if uptime > 30*time.Minute {
+			self.logRotateToErrorFile()
+		}
 
 		status = atomic.LoadInt32(&self.srv_status)
+		if SERVICE_ONESHOT == self.serviceType && SRV_STARTING == status {
+			self.completeOneshot(record)
+			isExited = true
+			break
+		}
 		switch status {
 		case SRV_RUNNING:
-			time.Sleep(2 * time.Second)
 		case SRV_STARTING:
-			if retries >= maxRetries {
+			if retries+1 >= maxRetries {
+				self.setStartError(record)
 				isRunning = false
 				break
 			}
 		default:
 			isRunning = false
+		}
+		if !isRunning {
 			break
 		}
 
-		if time.Now().Sub(restartAt) > 30*time.Minute {
-			self.logRotateToErrorFile()
-			// 	self.logString("logRotateToErrorFile()")
-			// 	fmt.Println("logRotateToErrorFile()")
-			// } else {
-			// 	self.logString("logRotateToErrorFile() skip")
-			// 	fmt.Println("logRotateToErrorFile() skip")
+		// 只有进程自己退出时才按重启策略判断， 被 daemontools 终止的总是重启
+		if !isSelfInterrupted(record.Reason) && !self.shouldRestart(record.ExitCode) {
+			self.logString(fmt.Sprintf("[sys] process is exited with code %d, restart policy is '%s', skip restart.\r\n", record.ExitCode, self.restartPolicy))
+			if SRV_STARTING == status {
+				self.setStartError(record)
+			}
+			isExited = true
+			break
+		}
+
+		if delay := self.nextRestartDelay(uptime); delay > 0 {
+			self.logString("[sys] restart after " + delay.String() + "\r\n")
+			self.sleep(delay)
 		}
 	}
 }
 
-func (self *supervisor_default) run(cb func()) {
+func (self *supervisor_default) run(cb func()) (record runRecord) {
+	record.ExitCode = -1
+
 	self.cond.L.Lock()
 	isLocked := true
 	defer func() {
@@ -352,6 +807,10 @@ func (self *supervisor_default) run(cb func()) {
 		}
 		self.stdin = nil
 		self.pid = 0
+		if "" != record.Reason {
+			record.EndAt = time.Now()
+			self.history.add(record)
+		}
 		self.cond.L.Unlock()
 
 		if st := atomic.LoadInt32(&self.srv_status); SRV_RUNNING == st || SRV_STARTING == st {
@@ -386,19 +845,56 @@ func (self *supervisor_default) run(cb func()) {
 
 	self.cleanBefore()
 
+	conditions := 0
+	if "" != self.success_flag || 0 != len(self.successFlags) {
+		conditions++
+	}
+	if nil != self.ready {
+		conditions++
+	}
+
+	var flags *flagWriter
+	readyc := make(chan struct{})
+	onReady := whenAll(conditions, func() {
+		close(readyc)
+		if nil != flags {
+			flags.setReady()
+		}
+		if nil != cb {
+			cb()
+		}
+	})
+
 	cmd := self.start_cmd.command(self.mode)
-	if self.success_flag == "" {
+	if 0 != len(self.successFlags) || 0 != len(self.failureFlags) {
+		successFlags := self.successFlags
+		if "" != self.success_flag {
+			successFlags = append(successFlags, regexp.MustCompile(regexp.QuoteMeta(self.success_flag)))
+		}
+		flags = &flagWriter{out: self.out,
+			success:   successFlags,
+			failure:   self.failureFlags,
+			onSuccess: onReady,
+			onFailure: func(pattern string) {
+				self.logString("[sys] failure flag '" + pattern + "' is matched, kill it.\r\n")
+				go self.interruptBy(EXIT_REASON_FAILURE_FLAG)
+			}}
+		tracked := self.trackOutput(flags)
+		cmd.Stdout = tracked
+		cmd.Stderr = tracked
+	} else if self.success_flag == "" {
 		if *is_print {
-			cmd.Stdout = os.Stdout
-			cmd.Stderr = os.Stderr
+			cmd.Stdout = self.trackOutput(os.Stdout)
+			cmd.Stderr = self.trackOutput(os.Stderr)
 		} else {
-			cmd.Stdout = self.out
-			cmd.Stderr = self.out
+			tracked := self.trackOutput(self.out)
+			cmd.Stdout = tracked
+			cmd.Stderr = tracked
 		}
 	} else {
-		wrapped := wrap(self.out, []byte(self.success_flag), cb)
-		cmd.Stdout = wrapped
-		cmd.Stderr = wrapped
+		tracked := self.trackOutput(wrap(self.out, []byte(self.success_flag), onReady))
+		cmd.Stdout = tracked
+		cmd.Stderr = tracked
 	}
 
 	var in io.WriteCloser
@@ -421,11 +917,33 @@ func (self *supervisor_default) run(cb func()) {
 	for _, s := range self.start_cmd.environments {
 		self.logString(fmt.Sprintf("[sys] \t\t[env]%v\r\n", s))
 	}
+	for idx := range self.start_cmd.limits {
+		self.logString(fmt.Sprintf("[sys] \t\t[limit]%v\r\n", self.start_cmd.limits[idx].String()))
+	}
+
+	oomKills := 0
+	if nil != self.cgroup {
+		if e := self.cgroup.setup(); nil != e {
+			self.logString(fmt.Sprintf("[sys] setup cgroup '%s' failed - %v\r\n", self.cgroup.path, e))
+		} else {
+			applyCgroup(cmd, self.cgroup.path)
+			oomKills = self.cgroup.oomKills()
+		}
+	}
 
 	self.onEvent(PROC_STARTING)
+	if nil != self.output {
+		self.output.Reset()
+	}
+	self.interruptReason = ""
+	self.interruptMessage = ""
+	record.StartAt = time.Now()
+	atomic.StoreInt64(&self.lastOutputAt, record.StartAt.UnixNano())
 	if e = cmd.Start(); nil != e {
+		record.Reason = EXIT_REASON_START_FAILED
+		record.Message = e.Error()
 
-		if self.success_flag == "" {
+		if 0 == conditions {
 			if nil != cb {
 				cb()
 			}
@@ -437,16 +955,33 @@ func (self *supervisor_default) run(cb func()) {
 	atomic.StoreInt32(&self.proc_status, PROC_RUNNING)
 	self.stdin = in
 	self.pid = cmd.Process.Pid
+	record.Pid = self.pid
 	self.cond.L.Unlock()
 	isLocked = false
-	if self.success_flag == "" {
-		if nil != cb {
-			cb()
-		}
+	if 0 == conditions {
+		onReady()
 	}
 
 	self.onEvent(PROC_RUNNING)
 
+	done := make(chan struct{})
+	defer close(done)
+	if self.watchdogSilence > 0 {
+		go self.watchdogLoop(done)
+	}
+	if nil != cb && self.startTimeout > 0 {
+		go self.startTimeoutLoop(done, readyc)
+	}
+	if nil != self.ready {
+		go self.readyLoop(done, onReady)
+	}
+	if nil != self.healthcheck {
+		go self.healthLoop(done)
+	}
+	if self.metricsInterval > 0 {
+		go self.metricsLoop(done, record.Pid)
+	}
+
 	// cmd.Wait() may blocked for ever in the win32.
 	ch := make(chan error, 3)
 	go func() {
@@ -464,14 +999,30 @@ func (self *supervisor_default) run(cb func()) {
 			if !IsInProcessList(self.pid, "") {
 				isStopped = true
 				self.closeStdin()
+				record.Reason = EXIT_REASON_LOST
 				self.logString("[sys] process pid('" + strconv.FormatInt(int64(self.pid), 10) + "') is not found.\r\n")
+				return
 			}
 		}
 	}
+	self.cond.L.Lock()
+	interruptReason := self.interruptReason
+	interruptMessage := self.interruptMessage
+	self.cond.L.Unlock()
+	record.setExit(cmd.ProcessState, e, interruptReason)
+	if "" != interruptMessage {
+		record.Message = interruptMessage
+	}
+	if nil != self.cgroup && self.cgroup.oomKills() > oomKills {
+		record.Reason = EXIT_REASON_OOM
+		record.Message = "killed by OOM"
+		self.logString("[sys] process is killed by OOM.\r\n")
+	}
 	if nil != e {
 		self.logString(fmt.Sprintf("[sys] wait process failed - %v\r\n", e))
 		return
 	}
 
 	self.logString("[sys] process is exited.\r\n")
+	return
 }
@@ -4,18 +4,61 @@ import (
 	"bufio"
 	"bytes"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"net/http"
 	_ "net/http/pprof"
 	"net/textproto"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 )
 
+// lockedBuffer 是可以同时被多个 goroutine 使用的 bytes.Buffer， 测试中进程的
+// 输出和健康检查等 goroutine 的日志会同时写到它里面
+type lockedBuffer struct {
+	lock   sync.Mutex
+	buffer bytes.Buffer
+}
+
+func (self *lockedBuffer) Write(p []byte) (int, error) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	return self.buffer.Write(p)
+}
+
+func (self *lockedBuffer) String() string {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	return self.buffer.String()
+}
+
+func (self *lockedBuffer) Reset() {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.buffer.Reset()
+}
+
+// untilRestarted 等待服务的进程退出后又重新启动， 返回第一次运行的记录和新的
+// pid， 超时返回 false
+func untilRestarted(s *supervisor_default, timeout time.Duration) (runRecord, int, bool) {
+	for deadline := time.Now().Add(timeout); time.Now().Before(deadline); time.Sleep(20 * time.Millisecond) {
+		runs := s.stats()["runs"].([]runRecord)
+		if 0 == len(runs) {
+			continue
+		}
+		if pid := int(s.GetStatus().Pid); 0 != pid && runs[0].Pid != pid {
+			return runs[0], pid, true
+		}
+	}
+	return runRecord{}, 0, false
+}
+
 func TestStart(t *testing.T) {
 	success_flag := "test_starts"
 	wd, _ := os.Getwd()
@@ -90,7 +133,7 @@ func TestStartWithPrompt(t *testing.T) {
 
 func TestStartWithRedirect(t *testing.T) {
 	success_flag := "TestStartWithRedirect"
-	var buffer bytes.Buffer
+	var buffer lockedBuffer
 	wd, _ := os.Getwd()
 	s := &supervisor_default{success_flag: success_flag,
 		supervisorBase: supervisorBase{proc_name: "test_start",
@@ -116,7 +159,7 @@ func TestStartWithRedirect(t *testing.T) {
 
 func TestStartWithEcho(t *testing.T) {
 	success_flag := "TestStartWithEcho"
-	var buffer bytes.Buffer
+	var buffer lockedBuffer
 	wd, _ := os.Getwd()
 	s := &supervisor_default{success_flag: success_flag,
 		supervisorBase: supervisorBase{proc_name: "test_start",
@@ -151,7 +194,7 @@ func TestStartFailed(t *testing.T) {
 	go http.ListenAndServe(":12345", nil)
 
 	success_flag := "TestStartFailed"
-	var buffer bytes.Buffer
+	var buffer lockedBuffer
 	wd, _ := os.Getwd()
 	s := &supervisor_default{success_flag: success_flag,
 		supervisorBase: supervisorBase{proc_name: "test_start",
@@ -206,7 +249,7 @@ func TestStartFailedWithRepectedCount(t *testing.T) {
 	}()
 
 	success_flag := "TestStartFailedWithRepectedCount"
-	var buffer bytes.Buffer
+	var buffer lockedBuffer
 	wd, _ := os.Getwd()
 	s := &supervisor_default{success_flag: success_flag,
 		supervisorBase: supervisorBase{proc_name: "test_start",
@@ -239,7 +282,7 @@ func TestStartFailedWithRepectedCount(t *testing.T) {
 func TestStopByCmd(t *testing.T) {
 	port := ":9483"
 	success_flag := "listen ok"
-	var buffer bytes.Buffer
+	var buffer lockedBuffer
 	wd, _ := os.Getwd()
 	s := &supervisor_default{success_flag: success_flag,
 		supervisorBase: supervisorBase{proc_name: "test_start",
@@ -281,7 +324,7 @@ func TestStopByCmd(t *testing.T) {
 
 func TestStopByNoStop(t *testing.T) {
 	success_flag := "ok"
-	var buffer bytes.Buffer
+	var buffer lockedBuffer
 	wd, _ := os.Getwd()
 	s := &supervisor_default{success_flag: success_flag,
 		supervisorBase: supervisorBase{proc_name: "test_start",
@@ -321,7 +364,7 @@ func TestStopByNoStop(t *testing.T) {
 
 func TestStopByConsole(t *testing.T) {
 	success_flag := "ok"
-	var buffer bytes.Buffer
+	var buffer lockedBuffer
 	wd, _ := os.Getwd()
 	s := &supervisor_default{success_flag: success_flag,
 		supervisorBase: supervisorBase{proc_name: "test_start",
@@ -369,7 +412,7 @@ func TestStopByConsole(t *testing.T) {
 
 func TestStopByConsoleWithErrorExec(t *testing.T) {
 	success_flag := "ok"
-	var buffer bytes.Buffer
+	var buffer lockedBuffer
 	wd, _ := os.Getwd()
 	s := &supervisor_default{success_flag: success_flag,
 		supervisorBase: supervisorBase{proc_name: "test_start",
@@ -412,7 +455,7 @@ func TestStopByConsoleWithErrorExec(t *testing.T) {
 
 func TestStopByKill(t *testing.T) {
 	success_flag := "ok"
-	var buffer bytes.Buffer
+	var buffer lockedBuffer
 	wd, _ := os.Getwd()
 	s := &supervisor_default{success_flag: success_flag,
 		supervisorBase: supervisorBase{proc_name: "test_start",
@@ -456,3 +499,357 @@ func TestStopByKill(t *testing.T) {
 	}
 	t.Log(ss)
 }
+
+func TestNextRestartDelay(t *testing.T) {
+	s := &supervisor_default{restartDelay: 1 * time.Second,
+		restartDelayMax:      5 * time.Second,
+		restartBackoffFactor: 2,
+		restartBackoffReset:  time.Minute}
+
+	for idx, excepted := range []time.Duration{1 * time.Second,
+		2 * time.Second,
+		4 * time.Second,
+		5 * time.Second,
+		5 * time.Second} {
+		if delay := s.nextRestartDelay(time.Second); excepted != delay {
+			t.Errorf("[%d] excepted is %v, actual is %v", idx, excepted, delay)
+		}
+	}
+
+	if delay := s.nextRestartDelay(2 * time.Minute); 1*time.Second != delay {
+		t.Errorf("excepted is reset to 1s, actual is %v", delay)
+	}
+}
+
+func TestShouldRestart(t *testing.T) {
+	for _, test := range []struct {
+		policy    string
+		codes     []int
+		exitCode  int
+		isRestart bool
+	}{{policy: RESTART_ALWAYS, exitCode: 0, isRestart: true},
+		{policy: RESTART_NEVER, exitCode: 1, isRestart: false},
+		{policy: RESTART_ON_FAILURE, exitCode: 0, isRestart: false},
+		{policy: RESTART_ON_FAILURE, exitCode: 1, isRestart: true},
+		{policy: RESTART_ON_FAILURE, exitCode: -1, isRestart: true},
+		{policy: RESTART_ON_FAILURE, codes: []int{0, 3}, exitCode: 3, isRestart: false}} {
+		s := &supervisor_default{restartPolicy: test.policy, successExitCodes: test.codes}
+		if isRestart := s.shouldRestart(test.exitCode); test.isRestart != isRestart {
+			t.Errorf("%v%v exit with %v, excepted is %v, actual is %v",
+				test.policy, test.codes, test.exitCode, test.isRestart, isRestart)
+		}
+	}
+}
+
+func TestToRestartPolicy(t *testing.T) {
+	for s, excepted := range map[string]string{"": RESTART_ALWAYS,
+		"Always":         RESTART_ALWAYS,
+		"unless-stopped": RESTART_ALWAYS,
+		"unless_stopped": RESTART_ALWAYS,
+		"on_failure":     RESTART_ON_FAILURE,
+		"no":             RESTART_NEVER} {
+		if policy, e := toRestartPolicy(s); nil != e {
+			t.Error(s, e)
+		} else if excepted != policy {
+			t.Errorf("%q excepted is %v, actual is %v", s, excepted, policy)
+		}
+	}
+	for _, s := range []string{"abc"} {
+		if _, e := toRestartPolicy(s); nil == e {
+			t.Error(s, "is accepted")
+		}
+	}
+}
+
+func TestRestartPolicyNever(t *testing.T) {
+	var buffer lockedBuffer
+	wd, _ := os.Getwd()
+	s := &supervisor_default{restartPolicy: RESTART_NEVER,
+		supervisorBase: supervisorBase{proc_name: "test_start",
+			retries:     5,
+			killTimeout: time.Second,
+			out:         &buffer,
+			start_cmd: &command{proc: "go",
+				arguments: []string{"run", filepath.Join(wd, "mock", "helloworld.go"), "TestRestartPolicyNever"}}}}
+
+	s.start()
+
+	defer func() {
+		s.stop()
+		s.untilStopped()
+	}()
+
+	for i := 0; i < 100; i++ {
+		if SRV_EXITED == atomic.LoadInt32(&s.srv_status) {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	ss := buffer.String()
+	if SRV_EXITED != atomic.LoadInt32(&s.srv_status) {
+		t.Error("excepted status is exited, actual is", srvString(atomic.LoadInt32(&s.srv_status)))
+		t.Error(ss)
+	} else if 1 != strings.Count(ss, "proc start") {
+		t.Error(ss)
+	}
+
+	runs := s.stats()["runs"].([]runRecord)
+	if 1 != len(runs) {
+		t.Error("excepted runs is 1, actual is", len(runs))
+	} else if EXIT_REASON_EXITED != runs[0].Reason || 0 != runs[0].ExitCode || 0 == runs[0].Pid {
+		t.Errorf("run record is invalid - %#v", runs[0])
+	}
+}
+
+func TestRunHistory(t *testing.T) {
+	history := runHistory{size: 3}
+	for i := 1; i <= 5; i++ {
+		history.add(runRecord{Pid: i})
+	}
+	runs := history.list()
+	if 3 != len(runs) || 3 != runs[0].Pid || 5 != runs[2].Pid {
+		t.Errorf("history is invalid - %#v", runs)
+	}
+	if last := history.last(); nil == last || 5 != last.Pid {
+		t.Errorf("last is invalid - %#v", last)
+	}
+}
+
+func TestStartWithFailureFlag(t *testing.T) {
+	var buffer lockedBuffer
+	wd, _ := os.Getwd()
+	s := &supervisor_default{success_flag: "never_matched",
+		failureFlags: []*regexp.Regexp{regexp.MustCompile("^ok")},
+		supervisorBase: supervisorBase{proc_name: "test_start",
+			retries:     2,
+			killTimeout: time.Second,
+			out:         &buffer,
+			start_cmd: &command{proc: "go",
+				arguments: []string{"run", filepath.Join(wd, "mock", "run_forever.go")}}}}
+
+	s.start()
+
+	defer func() {
+		s.stop()
+		s.untilStopped()
+	}()
+
+	if e := s.untilStarted(); nil == e {
+		t.Error(buffer.String())
+		return
+	}
+
+	runs := s.stats()["runs"].([]runRecord)
+	if 2 != len(runs) || EXIT_REASON_FAILURE_FLAG != runs[0].Reason {
+		t.Errorf("runs is invalid - %#v", runs)
+		t.Error(buffer.String())
+	}
+}
+
+func TestLoadFailureFlagWithoutSuccessFlag(t *testing.T) {
+	for _, test := range []struct {
+		config string
+		ok     bool
+	}{{config: `"success_flag": "ok", "failure_flag": "error"`, ok: true},
+		{config: `"ready": {"type": "file", "path": "ready"}, "failure_flag": "error"`, ok: true},
+		{config: `"failure_flag": "error"`, ok: false}} {
+		file := filepath.Join(t.TempDir(), "autostart_app.conf")
+		if e := ioutil.WriteFile(file, []byte(`{
+  "name": "app",
+  `+test.config+`,
+  "start": {
+    "execute": "app"
+  }
+}`), 0666); nil != e {
+			t.Fatal(e)
+		}
+
+		supervisors, e := loadConfig(file, map[string]interface{}{}, nil, nil)
+		if !test.ok {
+			if nil == e || !strings.Contains(e.Error(), "failure_flag") {
+				t.Error(test.config, e)
+			}
+			continue
+		}
+		if nil != e {
+			t.Error(test.config, e)
+		} else if s := supervisors[0].(*supervisor_default); 1 != len(s.failureFlags) {
+			t.Error(test.config, "failure_flag is missing")
+		}
+	}
+}
+
+func TestStartTimeout(t *testing.T) {
+	var buffer lockedBuffer
+	wd, _ := os.Getwd()
+	s := &supervisor_default{success_flag: "never_matched",
+		startTimeout: 300 * time.Millisecond,
+		supervisorBase: supervisorBase{proc_name: "test_start_timeout",
+			retries:     2,
+			killTimeout: time.Second,
+			out:         &buffer,
+			start_cmd: &command{proc: "go",
+				arguments: []string{"run", filepath.Join(wd, "mock", "run_forever.go")}}}}
+
+	s.start()
+
+	defer func() {
+		s.stop()
+		s.untilStopped()
+	}()
+
+	e := s.untilStarted()
+	if nil == e {
+		t.Error(buffer.String())
+		return
+	}
+	if !strings.Contains(e.Error(), "'test_start_timeout' is timed out") {
+		t.Error(e)
+	}
+
+	runs := s.stats()["runs"].([]runRecord)
+	if 2 != len(runs) || EXIT_REASON_START_TIMEOUT != runs[1].Reason {
+		t.Errorf("runs is invalid - %#v", runs)
+	}
+}
+
+func TestWatchdogSilence(t *testing.T) {
+	var buffer lockedBuffer
+	wd, _ := os.Getwd()
+	s := &supervisor_default{success_flag: "ok",
+		restartPolicy:   RESTART_NEVER,
+		watchdogSilence: 300 * time.Millisecond,
+		supervisorBase: supervisorBase{proc_name: "test_watchdog",
+			retries:     5,
+			killTimeout: time.Second,
+			out:         &buffer,
+			start_cmd: &command{proc: "go",
+				arguments: []string{"run", filepath.Join(wd, "mock", "run_forever.go")}}}}
+
+	s.start()
+
+	defer func() {
+		s.stop()
+		s.untilStopped()
+	}()
+
+	if e := s.untilStarted(); nil != e {
+		t.Fatal(e)
+	}
+	if _, ok := s.stats()["last_output_at"]; !ok {
+		t.Error("last_output_at is missing")
+	}
+
+	// 被 watchdog 杀死的进程即使重启策略为 never 也会重启
+	record, _, ok := untilRestarted(s, 10*time.Second)
+	if !ok {
+		t.Fatal("process is not restarted -", buffer.String())
+	}
+	if EXIT_REASON_WATCHDOG != record.Reason {
+		t.Errorf("run is invalid - %#v", record)
+	}
+	if ss := buffer.String(); !strings.Contains(ss, "[sys] watchdog") {
+		t.Error(ss)
+	}
+}
+
+func TestOneshot(t *testing.T) {
+	wd, _ := os.Getwd()
+	newOneshot := func(buffer *lockedBuffer, successExitCodes []int) *supervisor_default {
+		return &supervisor_default{serviceType: SERVICE_ONESHOT,
+			restartPolicy:    RESTART_NEVER,
+			successExitCodes: successExitCodes,
+			output:           &tailBuffer{size: 4096},
+			supervisorBase: supervisorBase{proc_name: "test_migrate",
+				retries:     5,
+				killTimeout: time.Second,
+				out:         buffer,
+				start_cmd: &command{proc: "go",
+					arguments: []string{"run", filepath.Join(wd, "mock", "helloworld.go"), "migrated"}}}}
+	}
+
+	var buffer lockedBuffer
+	s := newOneshot(&buffer, nil)
+	app := &supervisor_default{supervisorBase: supervisorBase{proc_name: "test_app",
+		dependsOn:   []string{"test_migrate"},
+		retries:     1,
+		killTimeout: time.Second,
+		out:         &buffer,
+		start_cmd: &command{proc: "go",
+			arguments: []string{"run", filepath.Join(wd, "mock", "run_forever.go")}}},
+		success_flag: "ok"}
+	mgr := &Manager{supervisors: []supervisor{app, s}}
+	if e := mgr.Restore(); nil != e {
+		t.Fatal(e)
+	}
+	defer mgr.stopAll(true)
+
+	if SRV_EXITED != atomic.LoadInt32(&s.srv_status) {
+		t.Error("status is", srvString(atomic.LoadInt32(&s.srv_status)))
+	}
+	runs := s.stats()["runs"].([]runRecord)
+	if 1 != len(runs) {
+		t.Errorf("runs is invalid - %#v", runs)
+	}
+	result, _ := s.stats()["result"].(map[string]interface{})
+	if nil == result || true != result["success"] || 0 != result["exit_code"] ||
+		!strings.Contains(fmt.Sprint(result["log"]), "migrated") {
+		t.Errorf("result is invalid - %#v", result)
+	}
+	if !app.stats()["is_started"].(bool) {
+		t.Error("app is not started")
+	}
+
+	buffer.Reset()
+	failed := newOneshot(&buffer, []int{3})
+	app.stop()
+	app.untilStopped()
+	mgr = &Manager{supervisors: []supervisor{app, failed}}
+	e := mgr.Restore()
+	if nil == e || !strings.Contains(e.Error(), "exit code is 0") || !strings.Contains(e.Error(), "'test_app' is skipped") {
+		t.Error(e)
+	}
+	runs = failed.stats()["runs"].([]runRecord)
+	if 1 != len(runs) {
+		t.Errorf("runs is invalid - %#v", runs)
+	}
+	if result, _ := failed.stats()["result"].(map[string]interface{}); nil == result || false != result["success"] {
+		t.Errorf("result is invalid - %#v", result)
+	}
+}
+
+func TestLoadOneshotWithResultLogSize(t *testing.T) {
+	for _, test := range []struct {
+		size string
+		ok   bool
+	}{{size: "1024", ok: true},
+		{size: "0", ok: false},
+		{size: "-1", ok: false}} {
+		file := filepath.Join(t.TempDir(), "autostart_migrate.conf")
+		if e := ioutil.WriteFile(file, []byte(`{
+  "name": "migrate",
+  "type": "oneshot",
+  "result_log_size": `+test.size+`,
+  "start": {
+    "execute": "migrate"
+  }
+}`), 0666); nil != e {
+			t.Fatal(e)
+		}
+
+		supervisors, e := loadConfig(file, map[string]interface{}{}, nil, nil)
+		if !test.ok {
+			if nil == e || !strings.Contains(e.Error(), "result_log_size") {
+				t.Error(test.size, e)
+			}
+			continue
+		}
+		if nil != e {
+			t.Fatal(test.size, e)
+		}
+		if s := supervisors[0].(*supervisor_default); 1024 != s.output.size {
+			t.Error("excepted result_log_size is 1024, actual is", s.output.size)
+		}
+	}
+}
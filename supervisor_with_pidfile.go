@@ -153,6 +153,10 @@ func (self *supervisorWithPidfile) interrupt() {
 		ok, txt = self.killBySignal(pid)
 	default:
 		ok, txt = self.killByCmd(pid)
+		if !ok {
+			self.logString(txt + "\r\n")
+			ok, txt = self.escalate(pid)
+		}
 	}
 
 	if ok {
@@ -165,6 +169,19 @@ func (self *supervisorWithPidfile) interrupt() {
 	}
 }
 
+func (self *supervisorWithPidfile) reload() error {
+	pid, e := self.readPidfile()
+	if nil != e {
+		return e
+	}
+	return self.reloadBy(pid)
+}
+
+// signal 被拒绝， 因为 pid 文件中的进程不是 daemontools 启动的
+func (self *supervisorWithPidfile) signal(name string, group bool) (string, error) {
+	return "", errNotOwned
+}
+
 func (self *supervisorWithPidfile) run(cb func()) {
 	cmd := self.start_cmd.command(self.mode)
 	//if 0 == len(self.prompt) {
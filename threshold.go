@@ -0,0 +1,261 @@
+package daemontools
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	THRESHOLD_RESTART = "restart"
+	THRESHOLD_EVENT   = "event"
+)
+
+// thresholdLimits 是百分比阈值所参照的 rlimit
+var thresholdLimits = map[string]string{
+	"fds":     "nofile",
+	"procs":   "nproc",
+	"threads": "nproc",
+}
+
+// thresholdRule 是一条资源阈值规则， 如 "max_rss: 2GB for 5m -> restart"
+// 或 "max_fds: 90% of nofile -> event"
+type thresholdRule struct {
+	metric   string
+	value    float64
+	percent  bool
+	duration time.Duration
+	action   string
+	text     string
+
+	breachedAt    time.Time
+	fired         bool
+	triggered     int
+	lastTriggered time.Time
+	lastMessage   string
+}
+
+type thresholds struct {
+	sync.Mutex
+	rules []*thresholdRule
+}
+
+func (self *thresholds) reset() {
+	self.Lock()
+	defer self.Unlock()
+	for _, rule := range self.rules {
+		rule.breachedAt = time.Time{}
+		rule.fired = false
+	}
+}
+
+func (self *thresholdRule) current(sample processSample, cpuPercent float64) float64 {
+	switch self.metric {
+	case "rss":
+		return float64(sample.rss)
+	case "vms":
+		return float64(sample.vms)
+	case "cpu":
+		return cpuPercent
+	case "threads":
+		return float64(sample.threads)
+	case "fds":
+		return float64(sample.fds)
+	case "procs":
+		return float64(sample.procs)
+	}
+	return 0
+}
+
+func (self *thresholdRule) format(value float64) string {
+	switch self.metric {
+	case "rss", "vms":
+		return formatBytes(uint64(value))
+	case "cpu":
+		return strconv.FormatFloat(value, 'f', 2, 64) + "%"
+	}
+	return strconv.FormatFloat(value, 'f', 0, 64)
+}
+
+// check 用一次采样的结果检查所有规则， 返回持续超过阈值且需要执行动作的规则及说明
+func (self *thresholds) check(pid int, sample processSample, cpuPercent float64, now time.Time) (fired []*thresholdRule, messages []string) {
+	self.Lock()
+	defer self.Unlock()
+
+	for _, rule := range self.rules {
+		limit := rule.value
+		if rule.percent {
+			value, ok := softRlimit(pid, thresholdLimits[rule.metric])
+			if !ok {
+				continue
+			}
+			limit = float64(value) * rule.value / 100
+		}
+
+		current := rule.current(sample, cpuPercent)
+		if current <= limit {
+			rule.breachedAt = time.Time{}
+			rule.fired = false
+			continue
+		}
+		if rule.breachedAt.IsZero() {
+			rule.breachedAt = now
+		}
+		if rule.fired || now.Sub(rule.breachedAt) < rule.duration {
+			continue
+		}
+
+		rule.fired = true
+		rule.triggered++
+		rule.lastTriggered = now
+		rule.lastMessage = fmt.Sprintf("%s is %s, greater than %s", rule.metric, rule.format(current), rule.format(limit))
+		if rule.duration > 0 {
+			rule.lastMessage += " for " + now.Sub(rule.breachedAt).String()
+		}
+		fired = append(fired, rule)
+		messages = append(messages, rule.lastMessage)
+	}
+	return fired, messages
+}
+
+func (self *thresholds) stats() []map[string]interface{} {
+	self.Lock()
+	defer self.Unlock()
+
+	results := make([]map[string]interface{}, 0, len(self.rules))
+	for _, rule := range self.rules {
+		res := map[string]interface{}{
+			"rule":      rule.text,
+			"triggered": rule.triggered,
+		}
+		if !rule.breachedAt.IsZero() {
+			res["breached_at"] = rule.breachedAt
+		}
+		if !rule.lastTriggered.IsZero() {
+			res["last_triggered_at"] = rule.lastTriggered
+			res["last_message"] = rule.lastMessage
+		}
+		results = append(results, res)
+	}
+	return results
+}
+
+// parseThreshold 解析 "<value>[ of <limit>][ for <duration>][ -> <action>]"
+func parseThreshold(key string, o interface{}) (*thresholdRule, error) {
+	if !strings.HasPrefix(key, "max_") {
+		return nil, errors.New("threshold '" + key + "' is unsupported.")
+	}
+	rule := &thresholdRule{metric: strings.TrimPrefix(key, "max_"), action: THRESHOLD_EVENT}
+	switch rule.metric {
+	case "rss", "vms", "cpu", "threads", "fds", "procs":
+	default:
+		return nil, errors.New("threshold '" + key + "' is unsupported.")
+	}
+
+	var s string
+	switch v := o.(type) {
+	case string:
+		s = strings.TrimSpace(v)
+	case float64:
+		s = strconv.FormatFloat(v, 'f', -1, 64)
+	case int:
+		s = strconv.Itoa(v)
+	case int64:
+		s = strconv.FormatInt(v, 10)
+	default:
+		return nil, fmt.Errorf("threshold '%v' is invalid - %v.", key, o)
+	}
+	rule.text = key + ": " + s
+
+	if idx := strings.Index(s, "->"); idx >= 0 {
+		rule.action = strings.ToLower(strings.TrimSpace(s[idx+2:]))
+		s = strings.TrimSpace(s[:idx])
+	}
+	switch rule.action {
+	case THRESHOLD_RESTART, THRESHOLD_EVENT:
+	default:
+		return nil, errors.New("action '" + rule.action + "' of threshold '" + key + "' is unsupported.")
+	}
+
+	if idx := strings.Index(s, " for "); idx >= 0 {
+		duration, e := time.ParseDuration(strings.TrimSpace(s[idx+5:]))
+		if nil != e || duration < 0 {
+			return nil, errors.New("duration of threshold '" + key + "' is invalid - " + s[idx+5:])
+		}
+		rule.duration = duration
+		s = strings.TrimSpace(s[:idx])
+	}
+
+	if idx := strings.Index(s, " of "); idx >= 0 {
+		if of := strings.TrimSpace(s[idx+4:]); of != thresholdLimits[rule.metric] {
+			return nil, errors.New("threshold '" + key + "' is invalid, '" + of + "' is unsupported.")
+		}
+		s = strings.TrimSpace(s[:idx])
+		if !strings.HasSuffix(s, "%") {
+			return nil, errors.New("threshold '" + key + "' is invalid, value must is a percent.")
+		}
+	}
+
+	if "cpu" == rule.metric {
+		value, e := strconv.ParseFloat(strings.TrimSuffix(s, "%"), 64)
+		if nil != e || value <= 0 {
+			return nil, errors.New("value of threshold '" + key + "' is invalid - " + s)
+		}
+		rule.value = value
+		return rule, nil
+	}
+
+	if strings.HasSuffix(s, "%") {
+		if _, ok := thresholdLimits[rule.metric]; !ok {
+			return nil, errors.New("value of threshold '" + key + "' is invalid, percent is unsupported.")
+		}
+		value, e := strconv.ParseFloat(strings.TrimSuffix(s, "%"), 64)
+		if nil != e || value <= 0 {
+			return nil, errors.New("value of threshold '" + key + "' is invalid - " + s)
+		}
+		rule.value = value
+		rule.percent = true
+		return rule, nil
+	}
+
+	unitName := "nofile"
+	if "rss" == rule.metric || "vms" == rule.metric {
+		unitName = "as"
+	}
+	value, e := parseRlimitValue(unitName, strings.Replace(s, " ", "", -1))
+	if nil != e || rlimitInfinity == value || 0 == value {
+		return nil, errors.New("value of threshold '" + key + "' is invalid - " + s)
+	}
+	rule.value = float64(value)
+	return rule, nil
+}
+
+func loadThresholds(o interface{}) (*thresholds, error) {
+	m, ok := o.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("'thresholds' is invalid.")
+	}
+
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	res := &thresholds{}
+	for _, key := range keys {
+		rule, e := parseThreshold(key, m[key])
+		if nil != e {
+			return nil, e
+		}
+		res.rules = append(res.rules, rule)
+	}
+	if 0 == len(res.rules) {
+		return nil, nil
+	}
+	return res, nil
+}
@@ -0,0 +1,187 @@
+package daemontools
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestParseThreshold(t *testing.T) {
+	for _, test := range []struct {
+		key      string
+		value    interface{}
+		metric   string
+		limit    float64
+		percent  bool
+		duration time.Duration
+		action   string
+		err      string
+	}{{key: "max_rss", value: "2GB for 5m -> restart", metric: "rss", limit: 2 * 1024 * 1024 * 1024, duration: 5 * time.Minute, action: THRESHOLD_RESTART},
+		{key: "max_rss", value: "512KiB", metric: "rss", limit: 512 * 1024, action: THRESHOLD_EVENT},
+		{key: "max_rss", value: "256MiB", metric: "rss", limit: 256 * 1024 * 1024, action: THRESHOLD_EVENT},
+		{key: "max_vms", value: "4GiB -> restart", metric: "vms", limit: 4 * 1024 * 1024 * 1024, action: THRESHOLD_RESTART},
+		{key: "max_fds", value: "90% of nofile -> event", metric: "fds", limit: 90, percent: true, action: THRESHOLD_EVENT},
+		{key: "max_cpu", value: "150% for 1m", metric: "cpu", limit: 150, duration: time.Minute, action: THRESHOLD_EVENT},
+		{key: "max_threads", value: float64(200), metric: "threads", limit: 200, action: THRESHOLD_EVENT},
+		{key: "max_rss", value: "90%", err: "percent is unsupported"},
+		{key: "max_fds", value: "90% of nproc", err: "'nproc' is unsupported"},
+		{key: "max_fds", value: "90 -> stop", err: "'stop' of threshold"},
+		{key: "max_disk", value: "1GB", err: "'max_disk' is unsupported"}} {
+		rule, e := parseThreshold(test.key, test.value)
+		if "" != test.err {
+			if nil == e || !strings.Contains(e.Error(), test.err) {
+				t.Error(test.key, test.value, "excepted error is", test.err, ", actual is", e)
+			}
+			continue
+		}
+		if nil != e {
+			t.Error(test.key, test.value, e)
+			continue
+		}
+		if rule.metric != test.metric || rule.value != test.limit || rule.percent != test.percent ||
+			rule.duration != test.duration || rule.action != test.action {
+			t.Errorf("%v: %v - %#v", test.key, test.value, rule)
+		}
+	}
+}
+
+func TestThresholdCheck(t *testing.T) {
+	rule, e := parseThreshold("max_rss", "1MB for 10s -> restart")
+	if nil != e {
+		t.Fatal(e)
+	}
+	ths := &thresholds{rules: []*thresholdRule{rule}}
+
+	now := time.Now()
+	high := processSample{rss: 2 * 1024 * 1024}
+	low := processSample{rss: 512 * 1024}
+
+	if fired, _ := ths.check(0, high, 0, now); 0 != len(fired) {
+		t.Error("fired before duration")
+	}
+	if fired, _ := ths.check(0, low, 0, now.Add(5*time.Second)); 0 != len(fired) {
+		t.Error("fired after recovered")
+	}
+	if fired, _ := ths.check(0, high, 0, now.Add(6*time.Second)); 0 != len(fired) {
+		t.Error("fired before duration")
+	}
+	fired, messages := ths.check(0, high, 0, now.Add(16*time.Second))
+	if 1 != len(fired) {
+		t.Fatal("not fired")
+	}
+	if !strings.Contains(messages[0], "rss is 2.0MB, greater than 1.0MB") {
+		t.Error(messages[0])
+	}
+	if fired, _ := ths.check(0, high, 0, now.Add(20*time.Second)); 0 != len(fired) {
+		t.Error("fired twice")
+	}
+	if 1 != ths.stats()[0]["triggered"] {
+		t.Error(ths.stats())
+	}
+}
+
+func TestThresholdRestart(t *testing.T) {
+	if "linux" != runtime.GOOS {
+		t.Skip("metrics is unsupported on", runtime.GOOS)
+	}
+
+	rule, e := parseThreshold("max_threads", "1 -> restart")
+	if nil != e {
+		t.Fatal(e)
+	}
+
+	var buffer lockedBuffer
+	wd, _ := os.Getwd()
+	s := &supervisor_default{restartPolicy: RESTART_NEVER,
+		metricsInterval: 100 * time.Millisecond,
+		thresholds:      &thresholds{rules: []*thresholdRule{rule}},
+		supervisorBase: supervisorBase{proc_name: "test_threshold_restart",
+			retries:     5,
+			killTimeout: time.Second,
+			out:         &buffer,
+			start_cmd: &command{proc: "go",
+				arguments: []string{"run", filepath.Join(wd, "mock", "run_forever.go")}}}}
+
+	s.start()
+
+	defer func() {
+		s.stop()
+		s.untilStopped()
+	}()
+
+	if e := s.untilStarted(); nil != e {
+		t.Fatal(e)
+	}
+
+	// restart 动作即使重启策略为 never 也会重启进程
+	record, _, ok := untilRestarted(s, 10*time.Second)
+	if !ok {
+		t.Fatal("process is not restarted -", buffer.String())
+	}
+	if EXIT_REASON_THRESHOLD != record.Reason || !strings.Contains(record.Message, "threads is") {
+		t.Errorf("run is invalid - %#v", record)
+	}
+	if ss := buffer.String(); !strings.Contains(ss, "[sys] threshold 'max_threads: 1 -> restart' is exceeded") {
+		t.Error(ss)
+	}
+}
+
+func TestThresholdEvent(t *testing.T) {
+	if "linux" != runtime.GOOS {
+		t.Skip("metrics is unsupported on", runtime.GOOS)
+	}
+
+	rule, e := parseThreshold("max_threads", "1 -> event")
+	if nil != e {
+		t.Fatal(e)
+	}
+
+	alerts := make(chan string, 10)
+	mgr := &Manager{}
+	mgr.OnAlert(func(name, message string) {
+		if "test_threshold_event" == name {
+			alerts <- message
+		}
+	})
+
+	var buffer lockedBuffer
+	wd, _ := os.Getwd()
+	s := &supervisor_default{metricsInterval: 100 * time.Millisecond,
+		thresholds: &thresholds{rules: []*thresholdRule{rule}},
+		supervisorBase: supervisorBase{proc_name: "test_threshold_event",
+			retries:     5,
+			killTimeout: time.Second,
+			out:         &buffer,
+			start_cmd: &command{proc: "go",
+				arguments: []string{"run", filepath.Join(wd, "mock", "run_forever.go")}}}}
+	s.setManager(mgr)
+	s.start()
+	defer func() {
+		s.stop()
+		s.untilStopped()
+	}()
+
+	select {
+	case message := <-alerts:
+		if !strings.Contains(message, "max_threads: 1 -> event") || !strings.Contains(message, "threads is") {
+			t.Error(message)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("alert is not delivered")
+	}
+	stats := s.stats()["thresholds"].([]map[string]interface{})
+	if 1 != len(stats) || 1 != stats[0]["triggered"] || !strings.Contains(fmt.Sprint(stats[0]["last_message"]), "threads is") {
+		t.Errorf("thresholds is invalid - %#v", stats)
+	}
+	if ss := buffer.String(); !strings.Contains(ss, "[sys] threshold 'max_threads: 1 -> event' is exceeded") {
+		t.Error(ss)
+	}
+	if SRV_RUNNING != atomic.LoadInt32(&s.srv_status) {
+		t.Error("status is", srvString(atomic.LoadInt32(&s.srv_status)))
+	}
+}
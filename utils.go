@@ -274,6 +274,38 @@ func intWithArguments(arguments []map[string]interface{}, key string, defaultVal
 	return defaultValue
 }
 
+func floatWithArguments(arguments []map[string]interface{}, key string, defaultValue float64) float64 {
+	for _, arg := range arguments {
+		v, ok := arg[key]
+		if !ok {
+			continue
+		}
+
+		switch value := v.(type) {
+		case float64:
+			return value
+		case float32:
+			return float64(value)
+		case int:
+			return float64(value)
+		case int64:
+			return float64(value)
+		case string:
+			f, e := strconv.ParseFloat(value, 64)
+			if nil == e {
+				return f
+			}
+		default:
+			s := fmt.Sprint(value)
+			f, e := strconv.ParseFloat(s, 64)
+			if nil == e {
+				return f
+			}
+		}
+	}
+	return defaultValue
+}
+
 func durationWithArguments(arguments []map[string]interface{}, key string, defaultValue time.Duration) time.Duration {
 	for _, arg := range arguments {
 		v, ok := arg[key]
@@ -0,0 +1,201 @@
+package daemontools
+
+import (
+	"crypto/sha1"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// maxWatchHashSize 是比较文件内容的最大文件大小， 更大的文件只比较大小和修改时间
+const maxWatchHashSize = 4 * 1024 * 1024
+
+// fileWatch 用轮询的方式监视文件， 文件改变后服务会被重启
+type fileWatch struct {
+	patterns []string
+	interval time.Duration
+	debounce time.Duration
+	// config 为 true 时同时监视服务所在的配置文件， 它改变时重新加载配置
+	config bool
+}
+
+type fileState struct {
+	size    int64
+	modTime time.Time
+	isDir   bool
+	hash    [sha1.Size]byte
+}
+
+func loadFileWatch(file string, arguments []map[string]interface{}) (*fileWatch, error) {
+	var patterns []string
+	for _, pattern := range stringsWithDefault(arguments[0], "watch", ",", nil) {
+		pattern = strings.TrimSpace(pattern)
+		if 0 == len(pattern) {
+			continue
+		}
+		if !filepath.IsAbs(pattern) {
+			pattern = filepath.Join(filepath.Dir(file), pattern)
+		}
+		if _, e := filepath.Match(pattern, ""); nil != e {
+			return nil, errors.New("'watch' is invalid, '" + pattern + "' is a bad pattern.")
+		}
+		patterns = append(patterns, filepath.Clean(pattern))
+	}
+	config := boolWithDefault(arguments[0], "watch_config", false)
+	if 0 == len(patterns) && !config {
+		return nil, nil
+	}
+
+	interval := durationWithArguments(arguments, "watch_interval", 2*time.Second)
+	if interval <= 0 {
+		return nil, errors.New("'watch_interval' must is greate 0s.")
+	}
+	debounce := durationWithArguments(arguments, "watch_debounce", 3*time.Second)
+	if debounce < 0 {
+		return nil, errors.New("'watch_debounce' must is greate or equal 0s.")
+	}
+	return &fileWatch{patterns: patterns, interval: interval, debounce: debounce, config: config}, nil
+}
+
+func (self *fileWatch) snapshot(patterns []string) map[string]fileState {
+	states := map[string]fileState{}
+	for _, pattern := range patterns {
+		matches, e := filepath.Glob(pattern)
+		if nil != e {
+			continue
+		}
+		for _, nm := range matches {
+			fi, e := os.Stat(nm)
+			if nil != e {
+				continue
+			}
+			state := fileState{size: fi.Size(), modTime: fi.ModTime(), isDir: fi.IsDir()}
+			if !state.isDir && state.size <= maxWatchHashSize {
+				state.hash = hashFile(nm)
+			}
+			states[nm] = state
+		}
+	}
+	return states
+}
+
+func hashFile(nm string) (sum [sha1.Size]byte) {
+	f, e := os.Open(nm)
+	if nil != e {
+		return sum
+	}
+	defer f.Close()
+
+	h := sha1.New()
+	if _, e := io.Copy(h, f); nil != e {
+		return sum
+	}
+	copy(sum[:], h.Sum(nil))
+	return sum
+}
+
+// diffSnapshot 返回新增、删除和改变了的文件
+func diffSnapshot(old, current map[string]fileState) []string {
+	var changed []string
+	for nm, state := range current {
+		if o, ok := old[nm]; !ok || o != state {
+			changed = append(changed, nm)
+		}
+	}
+	for nm := range old {
+		if _, ok := current[nm]; !ok {
+			changed = append(changed, nm)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+// watchPatterns 返回要监视的文件， watch_config 为 true 时包括配置文件
+func (self *supervisor_default) watchPatterns() []string {
+	patterns := self.watch.patterns
+	if self.watch.config && "" != self.file {
+		patterns = append([]string{self.file}, patterns...)
+	}
+	return patterns
+}
+
+// watchLoop 周期性的检查监视的文件， 文件改变并且在 debounce 内没有再改变后重启服务，
+// baseline 是服务启动时的快照， 服务被停止时 stopc 会被关闭。
+func (self *supervisor_default) watchLoop(stopc <-chan struct{}, baseline map[string]fileState) {
+	patterns := self.watchPatterns()
+	ticker := time.NewTicker(self.watch.interval)
+	defer ticker.Stop()
+
+	pending := map[string]bool{}
+	var changedAt time.Time
+	for {
+		select {
+		case <-stopc:
+			return
+		case <-ticker.C:
+		}
+
+		current := self.watch.snapshot(patterns)
+		if changed := diffSnapshot(baseline, current); 0 != len(changed) {
+			for _, nm := range changed {
+				pending[nm] = true
+			}
+			baseline = current
+			changedAt = time.Now()
+			continue
+		}
+		if 0 == len(pending) || time.Now().Sub(changedAt) < self.watch.debounce {
+			continue
+		}
+
+		configChanged := false
+		var files []string
+		for nm := range pending {
+			if self.watch.config && nm == self.file && nil != self.reloadConfigs {
+				configChanged = true
+				continue
+			}
+			files = append(files, nm)
+		}
+		sort.Strings(files)
+		pending = map[string]bool{}
+
+		go self.onWatchChanged(configChanged, files)
+	}
+}
+
+func (self *supervisor_default) onWatchChanged(configChanged bool, files []string) {
+	if configChanged {
+		self.logString("[sys] '" + self.file + "' is changed, reload configs.\r\n")
+		report, e := self.reloadConfigs()
+		if nil != e {
+			self.logString("[sys] reload configs failed, " + e.Error() + "\r\n")
+		}
+		if 0 == len(files) {
+			return
+		}
+		// 配置改变后服务已经被重新加载过了
+		if nil == e && !containsString(report.Unchanged, self.name()) {
+			return
+		}
+	}
+
+	self.logString("[sys] '" + strings.Join(files, "', '") + "' is changed, restart it.\r\n")
+	if self.stop() {
+		self.start()
+	}
+}
+
+func containsString(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
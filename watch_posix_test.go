@@ -0,0 +1,223 @@
+//go:build !windows
+// +build !windows
+
+package daemontools
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLoadFileWatch(t *testing.T) {
+	file := filepath.Join("/etc", "daemon", "autostart_a.conf")
+	watch, e := loadFileWatch(file, []map[string]interface{}{{
+		"watch":          "bin/a, /opt/a/conf/*.xml",
+		"watch_interval": "1s"}})
+	if nil != e {
+		t.Fatal(e)
+	}
+	if !reflect.DeepEqual(watch.patterns, []string{"/etc/daemon/bin/a", "/opt/a/conf/*.xml"}) {
+		t.Error(watch.patterns)
+	}
+	if time.Second != watch.interval || 3*time.Second != watch.debounce || watch.config {
+		t.Error(watch.interval, watch.debounce, watch.config)
+	}
+
+	if watch, e := loadFileWatch(file, []map[string]interface{}{{}}); nil != e || nil != watch {
+		t.Error(watch, e)
+	}
+	if _, e := loadFileWatch(file, []map[string]interface{}{{"watch": "a", "watch_interval": "0s"}}); nil == e {
+		t.Error("excepted error is 'watch_interval', actual is nil")
+	}
+}
+
+func TestDiffSnapshot(t *testing.T) {
+	dir := t.TempDir()
+	ioutil.WriteFile(filepath.Join(dir, "a.txt"), []byte("a"), 0666)
+	ioutil.WriteFile(filepath.Join(dir, "b.txt"), []byte("b"), 0666)
+
+	watch := &fileWatch{}
+	patterns := []string{filepath.Join(dir, "*.txt")}
+	old := watch.snapshot(patterns)
+	if changed := diffSnapshot(old, watch.snapshot(patterns)); 0 != len(changed) {
+		t.Error(changed)
+	}
+
+	// 大小和修改时间不变时也能通过内容发现改变
+	info := old[filepath.Join(dir, "a.txt")]
+	ioutil.WriteFile(filepath.Join(dir, "a.txt"), []byte("c"), 0666)
+	current := watch.snapshot(patterns)
+	state := current[filepath.Join(dir, "a.txt")]
+	state.modTime = info.modTime
+	current[filepath.Join(dir, "a.txt")] = state
+	ioutil.WriteFile(filepath.Join(dir, "c.txt"), []byte("c"), 0666)
+	current[filepath.Join(dir, "c.txt")] = fileState{}
+	delete(current, filepath.Join(dir, "b.txt"))
+
+	excepted := []string{filepath.Join(dir, "a.txt"), filepath.Join(dir, "b.txt"), filepath.Join(dir, "c.txt")}
+	if changed := diffSnapshot(old, current); !reflect.DeepEqual(excepted, changed) {
+		t.Error(changed)
+	}
+}
+
+func TestRestartOnWatchChanged(t *testing.T) {
+	watched := filepath.Join(t.TempDir(), "app.bin")
+	ioutil.WriteFile(watched, []byte("v1"), 0666)
+
+	var buffer lockedBuffer
+	s := &supervisor_default{success_flag: "ok",
+		watch: &fileWatch{patterns: []string{watched},
+			interval: 50 * time.Millisecond,
+			debounce: 300 * time.Millisecond},
+		supervisorBase: supervisorBase{proc_name: "test_watch",
+			retries:     1,
+			killTimeout: time.Second,
+			out:         &buffer,
+			start_cmd: &command{proc: "sh",
+				arguments: []string{"-c", "echo ok; while true; do sleep 0.1; done"}}}}
+	s.start()
+	defer func() {
+		s.stop()
+		s.untilStopped()
+	}()
+	if e := s.untilStarted(); nil != e {
+		t.Fatal(e)
+	}
+	pid := s.GetStatus().Pid
+
+	ioutil.WriteFile(watched, []byte("v2"), 0666)
+	time.Sleep(100 * time.Millisecond)
+	ioutil.WriteFile(watched, []byte("v3"), 0666)
+	time.Sleep(150 * time.Millisecond)
+	if current := s.GetStatus().Pid; pid != current {
+		t.Error("restarted before debounce is elapsed")
+	}
+
+	for i := 0; i < 40; i++ {
+		if current := s.GetStatus().Pid; 0 != current && pid != current {
+			break
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	if current := s.GetStatus().Pid; 0 == current || pid == current {
+		t.Error("not restarted after", watched, "is changed")
+	}
+	if !strings.Contains(buffer.String(), "is changed, restart it.") {
+		t.Error(buffer.String())
+	}
+}
+
+func TestReloadOnConfigChanged(t *testing.T) {
+	oldLogDir := LogDir
+	LogDir = t.TempDir()
+	defer func() {
+		LogDir = oldLogDir
+	}()
+
+	root := t.TempDir()
+	file := filepath.Join(root, "autostart_w.conf")
+	config := `{
+  "name": "w",
+  "success_flag": "ok",
+  "watch_config": true,
+  "watch_interval": "50ms",
+  "watch_debounce": "100ms",
+  "start": {
+    "execute": "sh",
+    "arguments": ["-c", "echo ok; while true; do sleep 0.1; done"]
+  }
+}`
+	if e := ioutil.WriteFile(file, []byte(config), 0666); nil != e {
+		t.Fatal(e)
+	}
+
+	mgr, e := loadConfigs(root, nil, "", nil, nil)
+	if nil != e {
+		t.Fatal(e)
+	}
+	defer mgr.cr.Stop()
+	defer func() {
+		mgr.stopAll(true)
+	}()
+	if e := mgr.Restore(); nil != e {
+		t.Fatal(e)
+	}
+	old := mgr.list()[0]
+
+	config = strings.Replace(config, "sleep 0.1", "sleep 0.2", 1)
+	if e := ioutil.WriteFile(file, []byte(config), 0666); nil != e {
+		t.Fatal(e)
+	}
+
+	for i := 0; i < 60 && old == mgr.list()[0]; i++ {
+		time.Sleep(50 * time.Millisecond)
+	}
+	current := mgr.list()[0]
+	if old == current {
+		t.Fatal("configs is not reloaded")
+	}
+	// 配置是在原来服务的 goroutine 中重新加载的， 要等它完成， 这时原来的服务
+	// 已经停止了， 新的服务也已经启动了
+	mgr.reloadLock.Lock()
+	mgr.reloadLock.Unlock()
+	if e := current.untilStarted(); nil != e {
+		t.Error(e)
+	}
+	if SRV_INIT != atomic.LoadInt32(&old.(*supervisor_default).srv_status) {
+		t.Error("old service is not stopped, status is", srvString(atomic.LoadInt32(&old.(*supervisor_default).srv_status)))
+	}
+	if current.logOutput() != old.logOutput() {
+		t.Error("log output is not reused")
+	}
+}
+
+func TestWatchAfterExited(t *testing.T) {
+	watched := filepath.Join(t.TempDir(), "job.sh")
+	ioutil.WriteFile(watched, []byte("v1"), 0666)
+
+	var buffer lockedBuffer
+	s := &supervisor_default{serviceType: SERVICE_ONESHOT,
+		restartPolicy: RESTART_NEVER,
+		watch: &fileWatch{patterns: []string{watched},
+			interval: 30 * time.Millisecond,
+			debounce: 100 * time.Millisecond},
+		supervisorBase: supervisorBase{proc_name: "test_watch_exited",
+			retries:     1,
+			killTimeout: time.Second,
+			out:         &buffer,
+			start_cmd:   &command{proc: "sh", arguments: []string{"-c", "echo done"}}}}
+	s.start()
+	defer func() {
+		s.stop()
+		s.untilStopped()
+	}()
+
+	runs := func() int {
+		return len(s.stats()["runs"].([]runRecord))
+	}
+	waitRuns := func(n int) {
+		for i := 0; i < 60 && (runs() < n || SRV_EXITED != atomic.LoadInt32(&s.srv_status)); i++ {
+			time.Sleep(50 * time.Millisecond)
+		}
+		if n != runs() || SRV_EXITED != atomic.LoadInt32(&s.srv_status) {
+			t.Fatal("excepted runs is", n, ", actual is", runs(), srvString(atomic.LoadInt32(&s.srv_status)))
+		}
+	}
+	waitRuns(1)
+
+	for idx, content := range []string{"v2", "v3"} {
+		ioutil.WriteFile(watched, []byte(content), 0666)
+		waitRuns(idx + 2)
+	}
+
+	// 旧的 watchLoop 没有退出时每次改变都会重启多次
+	time.Sleep(300 * time.Millisecond)
+	if count := strings.Count(buffer.String(), "is changed, restart it."); 2 != count || 3 != runs() {
+		t.Error("excepted restart 2 times, actual is", count, ", runs is", runs())
+	}
+}